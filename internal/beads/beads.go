@@ -10,6 +10,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -25,8 +26,25 @@ var (
 	ErrNotInstalled = errors.New("bd not installed: run 'pip install beads-cli' or see https://github.com/anthropics/beads")
 	ErrNotFound     = errors.New("issue not found")
 	ErrFlagTitle    = errors.New("title looks like a CLI flag (starts with '-'); use --title=\"...\" to set flag-like titles intentionally")
+
+	// ErrDuplicateBead means bd refused to create a bead whose ID is taken.
+	ErrDuplicateBead = errors.New("bead already exists")
+
+	// ErrDBLocked means the beads database was locked by another writer.
+	// The operation did not happen and is safe to retry.
+	ErrDBLocked = errors.New("beads database is locked")
 )
 
+// bdError is a bd failure classified as one of the sentinel errors above.
+// Its message is the bd command and stderr, as for unclassified failures.
+type bdError struct {
+	msg  string
+	kind error
+}
+
+func (e *bdError) Error() string { return e.msg }
+func (e *bdError) Unwrap() error { return e.kind }
+
 // bdAllowStale caches whether the installed bd supports --allow-stale.
 // The cache is keyed by the resolved bd path so tests and subprocess stubs that
 // replace bd on PATH get re-probed instead of reusing stale capability state.
@@ -507,8 +525,9 @@ func (b *Beads) Run(args ...string) ([]byte, error) {
 
 // wrapError wraps bd errors with context.
 // ZFC: Avoid parsing stderr to make decisions. Transport errors to agents instead.
-// Exception: ErrNotInstalled (exec.ErrNotFound) and ErrNotFound (issue lookup) are
-// acceptable as they enable basic error handling without decision-making.
+// Exception: ErrNotInstalled (exec.ErrNotFound), ErrNotFound (issue lookup),
+// ErrDBLocked and ErrDuplicateBead are acceptable as they enable basic error
+// handling (retry, idempotent create) without decision-making.
 func (b *Beads) wrapError(err error, stderr string, args []string) error {
 	stderr = strings.TrimSpace(stderr)
 
@@ -525,11 +544,51 @@ func (b *Beads) wrapError(err error, stderr string, args []string) error {
 	}
 
 	if stderr != "" {
-		return fmt.Errorf("bd %s: %s", strings.Join(args, " "), stderr)
+		msg := fmt.Sprintf("bd %s: %s", strings.Join(args, " "), stderr)
+		if kind := ClassifyStderr(stderr); kind != nil {
+			return &bdError{msg: msg, kind: kind}
+		}
+		return errors.New(msg)
 	}
 	return fmt.Errorf("bd %s: %w", strings.Join(args, " "), err)
 }
 
+// ClassifyStderr returns ErrDBLocked or ErrDuplicateBead when bd's stderr
+// shows that failure, and nil otherwise. Callers that run bd directly use it
+// so they recognize the same failures as the Beads wrapper.
+func ClassifyStderr(stderr string) error {
+	switch {
+	case isLockedStderr(stderr):
+		return ErrDBLocked
+	case isDuplicateStderr(stderr):
+		return ErrDuplicateBead
+	}
+	return nil
+}
+
+// isLockedStderr reports whether bd failed because the database was locked
+// (SQLite busy or a Dolt lock wait timeout).
+func isLockedStderr(stderr string) bool {
+	msg := strings.ToLower(stderr)
+	return strings.Contains(msg, "database is locked") ||
+		strings.Contains(msg, "sqlite_busy") ||
+		strings.Contains(msg, "lock wait timeout")
+}
+
+// beadExistsRe matches bd's own duplicate-ID message ("issue gt-abc already
+// exists"). A bare "already exists" also appears in unrelated failures, such
+// as a git branch or directory that already exists.
+var beadExistsRe = regexp.MustCompile(`(?i)\b(issue|bead|id)\s+\S+\s+already exists`)
+
+// isDuplicateStderr reports whether bd failed because the bead ID is taken.
+func isDuplicateStderr(stderr string) bool {
+	msg := strings.ToLower(stderr)
+	return strings.Contains(msg, "unique constraint failed") ||
+		strings.Contains(msg, "duplicate primary key") ||
+		(strings.Contains(msg, "duplicate entry") && strings.Contains(msg, "for key")) ||
+		beadExistsRe.MatchString(stderr)
+}
+
 // isSubprocessCrash returns true if the error indicates the subprocess crashed
 // (e.g., Dolt nil pointer dereference causing SIGSEGV). This is used to detect
 // recoverable failures where a fallback strategy should be attempted (GH#1769).
@@ -1385,6 +1444,12 @@ func (b *Beads) ReleaseWithReason(id, reason string) error {
 	return err
 }
 
+// AddComment adds a comment to an issue.
+func (b *Beads) AddComment(id, text string) error {
+	_, err := b.run("comments", "add", id, text)
+	return err
+}
+
 // AddDependency adds a dependency: issue depends on dependsOn.
 func (b *Beads) AddDependency(issue, dependsOn string) error {
 	_, err := b.run("dep", "add", issue, dependsOn)
@@ -55,6 +55,7 @@ type AgentFields struct {
 	Branch         string // Polecat working branch name
 	MRFailed       bool   // True when MR creation was attempted but failed
 	CompletionTime string // RFC3339 timestamp of when gt done was called
+	ResumeNote     string // Where deferred work stood (gt done --checkpoint)
 }
 
 // Notification level constants
@@ -129,6 +130,9 @@ func FormatAgentDescription(title string, fields *AgentFields) string {
 	if fields.CompletionTime != "" {
 		lines = append(lines, fmt.Sprintf("completion_time: %s", fields.CompletionTime))
 	}
+	if fields.ResumeNote != "" {
+		lines = append(lines, fmt.Sprintf("resume_note: %s", fields.ResumeNote))
+	}
 
 	return strings.Join(lines, "\n")
 }
@@ -182,6 +186,8 @@ func ParseAgentFields(description string) *AgentFields {
 			fields.MRFailed = value == "true"
 		case "completion_time":
 			fields.CompletionTime = value
+		case "resume_note":
+			fields.ResumeNote = value
 		}
 	}
 
@@ -410,6 +416,7 @@ func (b *Beads) ResetAgentBeadForReuse(id, reason string) error {
 	fields.Branch = ""
 	fields.MRFailed = false
 	fields.CompletionTime = ""
+	fields.ResumeNote = ""
 
 	// Update description with cleared fields
 	description := FormatAgentDescription(issue.Title, fields)
@@ -458,6 +465,7 @@ type AgentFieldUpdates struct {
 	Branch         *string
 	MRFailed       *bool
 	CompletionTime *string
+	ResumeNote     *string
 }
 
 // UpdateAgentDescriptionFields atomically updates one or more agent description
@@ -517,6 +525,10 @@ func (b *Beads) UpdateAgentDescriptionFields(id string, updates AgentFieldUpdate
 	if updates.CompletionTime != nil {
 		fields.CompletionTime = *updates.CompletionTime
 	}
+	if updates.ResumeNote != nil {
+		// Description fields are single lines.
+		fields.ResumeNote = strings.Join(strings.Fields(*updates.ResumeNote), " ")
+	}
 
 	description := FormatAgentDescription(issue.Title, fields)
 	return b.Update(id, UpdateOptions{Description: &description})
@@ -580,6 +592,7 @@ func (b *Beads) ClearAgentCompletion(id string) error {
 		Branch:         &empty,
 		MRFailed:       &notFailed,
 		CompletionTime: &empty,
+		ResumeNote:     &empty,
 	})
 }
 
@@ -0,0 +1,30 @@
+package beads
+
+import "fmt"
+
+// UpdateConvoyFields applies update to the convoy fields of a convoy bead
+// under the bead lock and writes the result back. Other description content
+// is preserved.
+func (b *Beads) UpdateConvoyFields(convoyID string, update func(*ConvoyFields)) error {
+	unlock, err := b.lockBead(convoyID)
+	if err != nil {
+		return fmt.Errorf("acquiring bead lock: %w", err)
+	}
+	defer unlock()
+
+	issue, err := b.Show(convoyID)
+	if err != nil {
+		return err
+	}
+	if issue.Type != "convoy" {
+		return fmt.Errorf("'%s' is not a convoy (type: %s)", convoyID, issue.Type)
+	}
+	fields := ParseConvoyFields(issue)
+	if fields == nil {
+		fields = &ConvoyFields{}
+	}
+	update(fields)
+
+	desc := SetConvoyFields(issue, fields)
+	return b.Update(convoyID, UpdateOptions{Description: &desc})
+}
@@ -0,0 +1,53 @@
+package beads
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUpdateConvoyFields_RoundTrip(t *testing.T) {
+	store := installStatefulMockBD(t)
+	store.add(mockBead{
+		ID:          "hq-cv-abc",
+		Type:        "convoy",
+		Description: "Ship the widget.\n\nowner: mayor/",
+	})
+	bd := newMockBeads(t)
+
+	err := bd.UpdateConvoyFields("hq-cv-abc", func(f *ConvoyFields) {
+		f.PinnedState = "active"
+		f.PinnedUntil = "2026-01-02T09:00:00Z"
+	})
+	if err != nil {
+		t.Fatalf("UpdateConvoyFields: %v", err)
+	}
+
+	desc := store.get("hq-cv-abc", "description")
+	fields := ParseConvoyFields(&Issue{Description: desc})
+	if fields == nil || fields.PinnedState != "active" || fields.PinnedUntil != "2026-01-02T09:00:00Z" {
+		t.Errorf("pin not written:\n%s", desc)
+	}
+	if fields != nil && fields.Owner != "mayor/" {
+		t.Errorf("owner = %q, want existing field preserved:\n%s", fields.Owner, desc)
+	}
+	if !strings.Contains(desc, "Ship the widget.") {
+		t.Errorf("existing description lost:\n%s", desc)
+	}
+}
+
+func TestUpdateConvoyFields_Errors(t *testing.T) {
+	store := installStatefulMockBD(t)
+	store.add(mockBead{ID: "gt-task", Type: "task", Description: "Not a convoy."})
+	bd := newMockBeads(t)
+
+	noop := func(*ConvoyFields) {}
+	if err := bd.UpdateConvoyFields("hq-cv-missing", noop); err == nil {
+		t.Error("expected error for missing convoy")
+	}
+	if err := bd.UpdateConvoyFields("gt-task", noop); err == nil || !strings.Contains(err.Error(), "not a convoy") {
+		t.Errorf("expected not-a-convoy error, got %v", err)
+	}
+	if got := store.get("gt-task", "description"); got != "Not a convoy." {
+		t.Errorf("non-convoy bead modified: %q", got)
+	}
+}
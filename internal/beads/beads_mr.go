@@ -2,6 +2,7 @@
 package beads
 
 import (
+	"fmt"
 	"strings"
 )
 
@@ -45,3 +46,61 @@ func (b *Beads) findMRForBranch(branch string, skipClosed bool) (*Issue, error)
 
 	return nil, nil
 }
+
+// updateMRFields applies update to the MR fields of a merge-request bead
+// under the bead lock and writes the result back. Other description content
+// is preserved.
+func (b *Beads) updateMRFields(mrID string, update func(*MRFields)) (*MRFields, error) {
+	unlock, err := b.lockBead(mrID)
+	if err != nil {
+		return nil, fmt.Errorf("acquiring bead lock: %w", err)
+	}
+	defer unlock()
+
+	issue, err := b.Show(mrID)
+	if err != nil {
+		return nil, err
+	}
+	fields := ParseMRFields(issue)
+	if fields == nil {
+		return nil, fmt.Errorf("%s is not a merge request (no MR fields)", mrID)
+	}
+	update(fields)
+
+	desc := SetMRFields(issue, fields)
+	if err := b.Update(mrID, UpdateOptions{Description: &desc}); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// IncrementMRRetry records another conflict-resolution cycle on an MR bead:
+// retry_count is incremented and last_conflict_sha set to conflictSHA.
+// Returns the new retry count.
+func (b *Beads) IncrementMRRetry(mrID string, conflictSHA string) (int, error) {
+	fields, err := b.updateMRFields(mrID, func(f *MRFields) {
+		f.RetryCount++
+		f.LastConflictSHA = conflictSHA
+	})
+	if err != nil {
+		return 0, err
+	}
+	return fields.RetryCount, nil
+}
+
+// SetConflictTask links an MR bead to its conflict-resolution task.
+// Pass an empty taskID to clear the link.
+func (b *Beads) SetConflictTask(mrID, taskID string) error {
+	_, err := b.updateMRFields(mrID, func(f *MRFields) {
+		f.ConflictTaskID = taskID
+	})
+	return err
+}
+
+// SetMRPRURL records an externally-created pull request on an MR bead.
+func (b *Beads) SetMRPRURL(mrID, prURL string) error {
+	_, err := b.updateMRFields(mrID, func(f *MRFields) {
+		f.PRURL = prURL
+	})
+	return err
+}
@@ -0,0 +1,89 @@
+package beads
+
+import (
+	"strings"
+	"testing"
+)
+
+const testMRDescription = "branch: polecat/nux/gt-abc\ntarget: main\nsource_issue: gt-abc\nrig: gastown\nretry_count: 0\nlast_conflict_sha: null\nconflict_task_id: null\n\nReviewer notes stay put."
+
+func TestIncrementMRRetry_RoundTrip(t *testing.T) {
+	store := installStatefulMockBD(t)
+	store.add(mockBead{ID: "gt-mr1", Description: testMRDescription, Labels: []string{"gt:merge-request"}})
+	bd := newMockBeads(t)
+
+	n, err := bd.IncrementMRRetry("gt-mr1", "abc123")
+	if err != nil {
+		t.Fatalf("IncrementMRRetry: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("retry count = %d, want 1", n)
+	}
+
+	n, err = bd.IncrementMRRetry("gt-mr1", "def456")
+	if err != nil {
+		t.Fatalf("IncrementMRRetry (second): %v", err)
+	}
+	if n != 2 {
+		t.Errorf("retry count = %d, want 2", n)
+	}
+
+	issue, err := bd.Show("gt-mr1")
+	if err != nil {
+		t.Fatalf("Show: %v", err)
+	}
+	fields := ParseMRFields(issue)
+	if fields.RetryCount != 2 || fields.LastConflictSHA != "def456" {
+		t.Errorf("fields = retry %d sha %q, want 2 def456", fields.RetryCount, fields.LastConflictSHA)
+	}
+	if fields.Branch != "polecat/nux/gt-abc" || fields.SourceIssue != "gt-abc" {
+		t.Errorf("unrelated MR fields lost: %+v", fields)
+	}
+	desc := store.get("gt-mr1", "description")
+	if !strings.HasPrefix(desc, "branch: polecat/nux/gt-abc\n") {
+		t.Errorf("branch must stay first for FindMRForBranch: %q", desc)
+	}
+	if !strings.Contains(desc, "Reviewer notes stay put.") {
+		t.Errorf("free-form description lost: %q", desc)
+	}
+}
+
+func TestSetConflictTask_RoundTrip(t *testing.T) {
+	store := installStatefulMockBD(t)
+	store.add(mockBead{ID: "gt-mr1", Description: testMRDescription})
+	bd := newMockBeads(t)
+
+	if err := bd.SetConflictTask("gt-mr1", "gt-task9"); err != nil {
+		t.Fatalf("SetConflictTask: %v", err)
+	}
+	issue, err := bd.Show("gt-mr1")
+	if err != nil {
+		t.Fatalf("Show: %v", err)
+	}
+	if got := ParseMRFields(issue).ConflictTaskID; got != "gt-task9" {
+		t.Errorf("ConflictTaskID = %q, want gt-task9", got)
+	}
+
+	if err := bd.SetConflictTask("gt-mr1", ""); err != nil {
+		t.Fatalf("SetConflictTask (clear): %v", err)
+	}
+	if desc := store.get("gt-mr1", "description"); strings.Contains(desc, "conflict_task_id") {
+		t.Errorf("conflict_task_id not cleared: %q", desc)
+	}
+}
+
+func TestIncrementMRRetry_Errors(t *testing.T) {
+	store := installStatefulMockBD(t)
+	store.add(mockBead{ID: "gt-task1", Description: "Just a task."})
+	bd := newMockBeads(t)
+
+	if _, err := bd.IncrementMRRetry("gt-missing", "abc123"); err == nil {
+		t.Error("expected error for missing MR")
+	}
+	if _, err := bd.IncrementMRRetry("gt-task1", "abc123"); err == nil {
+		t.Error("expected error for bead without MR fields")
+	}
+	if desc := store.get("gt-task1", "description"); desc != "Just a task." {
+		t.Errorf("non-MR bead modified: %q", desc)
+	}
+}
@@ -2,6 +2,7 @@ package beads
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -222,6 +223,91 @@ func TestWrapError(t *testing.T) {
 	}
 }
 
+// TestWrapError_Classified tests that lock and duplicate failures unwrap to
+// their sentinel errors while keeping the bd message.
+func TestWrapError_Classified(t *testing.T) {
+	b := New("/test")
+
+	tests := []struct {
+		stderr  string
+		wantErr error
+	}{
+		{"Error: database is locked", ErrDBLocked},
+		{"SQLITE_BUSY: cannot start a transaction", ErrDBLocked},
+		{"Error 1205: Lock wait timeout exceeded", ErrDBLocked},
+		{"UNIQUE constraint failed: issues.id", ErrDuplicateBead},
+		{"duplicate primary key given: [gt-abc]", ErrDuplicateBead},
+		{"issue gt-abc already exists", ErrDuplicateBead},
+		{"Error 1062: Duplicate entry 'gt-abc' for key 'issues.PRIMARY'", ErrDuplicateBead},
+		{"fatal: a branch named 'polecat/nux' already exists", nil},
+		{"mkdir /tmp/x: file already exists", nil},
+		{"invalid priority 9", nil},
+	}
+
+	for _, tt := range tests {
+		err := b.wrapError(errors.New("exit status 1"), tt.stderr, []string{"create"})
+		if err == nil {
+			t.Fatalf("wrapError(%q) = nil, want error", tt.stderr)
+		}
+		for _, sentinel := range []error{ErrDBLocked, ErrDuplicateBead, ErrNotFound} {
+			if got, want := errors.Is(err, sentinel), sentinel == tt.wantErr; got != want {
+				t.Errorf("wrapError(%q): errors.Is(%v) = %v, want %v", tt.stderr, sentinel, got, want)
+			}
+		}
+		if want := "bd create: " + tt.stderr; err.Error() != want {
+			t.Errorf("wrapError(%q).Error() = %q, want %q", tt.stderr, err.Error(), want)
+		}
+	}
+}
+
+// installMockBDFailing installs a bd that prints stderr and exits 1 for
+// every command except version.
+func installMockBDFailing(t *testing.T, stderr string) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("mock bd requires sh")
+	}
+	binDir := t.TempDir()
+	script := "#!/bin/sh\nfor arg in \"$@\"; do\n  [ \"$arg\" = version ] && exit 0\ndone\necho \"$MOCK_BD_STDERR\" >&2\nexit 1\n"
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write mock bd: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("MOCK_BD_STDERR", stderr)
+}
+
+// TestShowCreate_ErrorTypes tests that Show and Create surface each bd
+// failure mode as its sentinel error.
+func TestShowCreate_ErrorTypes(t *testing.T) {
+	tests := []struct {
+		name    string
+		stderr  string
+		wantErr error
+	}{
+		{"not found", "Error: issue not found: gt-abc", ErrNotFound},
+		{"locked", "Error: database is locked", ErrDBLocked},
+		{"duplicate", "Error: UNIQUE constraint failed: issues.id", ErrDuplicateBead},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			installMockBDFailing(t, tt.stderr)
+			tmpDir := t.TempDir()
+			if err := os.MkdirAll(filepath.Join(tmpDir, ".beads"), 0755); err != nil {
+				t.Fatal(err)
+			}
+			bd := NewIsolated(tmpDir)
+
+			if _, err := bd.Show("gt-abc"); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Show error = %v, want %v", err, tt.wantErr)
+			}
+			if _, err := bd.Create(CreateOptions{Title: "Merge: gt-abc", Priority: -1}); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Create error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 // TestNormalizeBugTitle tests title normalization for duplicate detection.
 func TestNormalizeBugTitle(t *testing.T) {
 	tests := []struct {
@@ -597,6 +683,42 @@ worker: Toast`,
 			want: `merge_commit: deadbeef
 close_reason: rejected`,
 		},
+		{
+			name: "diffstat",
+			fields: &MRFields{
+				Branch:   "polecat/Nux/gt-xyz",
+				DiffStat: "3 files changed, +10 -2",
+			},
+			want: `branch: polecat/Nux/gt-xyz
+diffstat: 3 files changed, +10 -2`,
+		},
+		{
+			name: "profile",
+			fields: &MRFields{
+				Branch:  "polecat/Nux/gt-xyz",
+				Profile: "codex",
+			},
+			want: `branch: polecat/Nux/gt-xyz
+profile: codex`,
+		},
+		{
+			name: "pr url",
+			fields: &MRFields{
+				Branch: "polecat/Nux/gt-xyz",
+				PRURL:  "https://github.com/org/repo/pull/42",
+			},
+			want: `branch: polecat/Nux/gt-xyz
+pr_url: https://github.com/org/repo/pull/42`,
+		},
+		{
+			name: "remote",
+			fields: &MRFields{
+				Branch: "polecat/Nux/gt-xyz",
+				Remote: "staging",
+			},
+			want: `branch: polecat/Nux/gt-xyz
+remote: staging`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -778,6 +900,55 @@ rig: gastown`
 	}
 }
 
+func TestParseMRFieldsStrict(t *testing.T) {
+	// As written by gt done.
+	doneDescription := "branch: polecat/nux/gt-abc\ntarget: main\nsource_issue: gt-abc\nrig: gastown\nworker: nux\nretry_count: 2\nlast_conflict_sha: null\nconflict_task_id: null"
+
+	tests := []struct {
+		name    string
+		desc    string
+		wantErr string // substring; empty means no error
+	}{
+		{name: "gt done description", desc: doneDescription},
+		{name: "prose around fields", desc: "Submitted after review.\nbranch: feature/x\ntarget: integration/gt-epic\n\nSee https://example.com"},
+		{name: "no fields", desc: "Just some text.", wantErr: "no merge-request fields"},
+		{name: "missing branch", desc: "target: main\nsource_issue: gt-abc", wantErr: "missing branch"},
+		{name: "missing target", desc: "branch: polecat/nux/gt-abc", wantErr: "missing target"},
+		{name: "non-numeric retry_count", desc: "branch: b\ntarget: main\nretry_count: lots", wantErr: `invalid retry_count "lots"`},
+		{name: "negative retry_count", desc: "branch: b\ntarget: main\nretry_count: -1", wantErr: `invalid retry_count "-1"`},
+		{name: "several problems reported together", desc: "source_issue: gt-abc\nretry_count: x", wantErr: "missing branch; missing target; invalid retry_count"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, err := ParseMRFieldsStrict(&Issue{Description: tt.desc})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if fields == nil || fields.Branch == "" || fields.Target == "" {
+					t.Errorf("fields = %+v, want branch and target", fields)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	fields, err := ParseMRFieldsStrict(&Issue{Description: doneDescription})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fields.SourceIssue != "gt-abc" || fields.Rig != "gastown" || fields.Worker != "nux" || fields.RetryCount != 2 {
+		t.Errorf("typed fields not parsed: %+v", fields)
+	}
+
+	if _, err := ParseMRFieldsStrict(nil); err == nil {
+		t.Error("expected error for nil issue")
+	}
+}
+
 // TestSetMRFieldsPreservesURL tests that URLs in prose are preserved.
 func TestSetMRFieldsPreservesURL(t *testing.T) {
 	// URLs contain colons which could be confused with key: value
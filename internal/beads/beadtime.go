@@ -0,0 +1,37 @@
+package beads
+
+import (
+	"strings"
+	"time"
+)
+
+// beadTimeLayouts are the timestamp formats bd and gt emit, most common
+// first. Fractional seconds need no layouts of their own: time.Parse
+// accepts them after the seconds field. Layouts without a zone parse as UTC.
+var beadTimeLayouts = []string{
+	time.RFC3339,                    // 2026-01-02T15:04:05Z, 2026-01-02T15:04:05.123-07:00
+	"2006-01-02T15:04:05Z0700",      // Offset without colon: 2026-01-02T15:04:05-0700
+	"2006-01-02 15:04:05Z07:00",     // Space separator with zone
+	"2006-01-02 15:04:05 -0700 MST", // Go's time.Time.String
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02T15:04:05", // No zone
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// ParseTime parses a bead or event timestamp, trying beadTimeLayouts in
+// order. It returns false (and the zero time) if s is empty or matches none
+// of them, so callers can tell "unknown" apart from a real time.
+func ParseTime(s string) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range beadTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
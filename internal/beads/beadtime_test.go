@@ -0,0 +1,53 @@
+package beads
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTime(t *testing.T) {
+	utc := func(h, m, s, ns int) time.Time { return time.Date(2026, 3, 14, h, m, s, ns, time.UTC) }
+	minus7 := time.FixedZone("", -7*60*60)
+
+	tests := []struct {
+		name string
+		in   string
+		want time.Time
+	}{
+		{"RFC3339 UTC", "2026-03-14T09:26:53Z", utc(9, 26, 53, 0)},
+		{"RFC3339 offset", "2026-03-14T02:26:53-07:00", time.Date(2026, 3, 14, 2, 26, 53, 0, minus7)},
+		{"RFC3339 fractional", "2026-03-14T09:26:53.123456Z", utc(9, 26, 53, 123456000)},
+		{"offset without colon", "2026-03-14T02:26:53-0700", time.Date(2026, 3, 14, 2, 26, 53, 0, minus7)},
+		{"space separator with zone", "2026-03-14 09:26:53Z", utc(9, 26, 53, 0)},
+		{"Go time String", "2026-03-14 02:26:53.5 -0700 MST", time.Date(2026, 3, 14, 2, 26, 53, 500000000, minus7)},
+		{"space offset", "2026-03-14 02:26:53 -0700", time.Date(2026, 3, 14, 2, 26, 53, 0, minus7)},
+		{"no zone T", "2026-03-14T09:26:53", utc(9, 26, 53, 0)},
+		{"no zone space", "2026-03-14 09:26:53", utc(9, 26, 53, 0)},
+		{"minutes only", "2026-03-14 09:26", utc(9, 26, 0, 0)},
+		{"date only", "2026-03-14", utc(0, 0, 0, 0)},
+		{"surrounding space", "  2026-03-14T09:26:53Z\n", utc(9, 26, 53, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseTime(tt.in)
+			if !ok {
+				t.Fatalf("ParseTime(%q) failed", tt.in)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseTime(%q) = %s, want %s", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTime_Unparseable(t *testing.T) {
+	for _, in := range []string{"", "   ", "yesterday", "14/03/2026 09:26", "2026-13-45T99:99:99Z"} {
+		got, ok := ParseTime(in)
+		if ok {
+			t.Errorf("ParseTime(%q) = %s, want failure", in, got)
+		}
+		if !got.IsZero() {
+			t.Errorf("ParseTime(%q) returned non-zero time %s on failure", in, got)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -16,6 +17,7 @@ type AttachmentFields struct {
 	AttachedMolecule string // Root issue ID of the attached molecule
 	AttachedFormula  string // Formula name (e.g., "mol-polecat-work") for inline step display
 	AttachedAt       string // ISO 8601 timestamp when attached
+	HookedAt         string // ISO 8601 timestamp when gt sling last hooked the bead to an agent
 	AttachedArgs     string // Natural language args passed via gt sling --args (no-tmux mode)
 	AttachedVars     []string // Formula variables passed via gt sling --var
 	DispatchedBy     string // Agent ID that dispatched this work (for completion notification)
@@ -66,6 +68,9 @@ func ParseAttachmentFields(issue *Issue) *AttachmentFields {
 		case "attached_at", "attached-at", "attachedat":
 			fields.AttachedAt = value
 			hasFields = true
+		case "hooked_at", "hooked-at", "hookedat":
+			fields.HookedAt = value
+			hasFields = true
 		case "attached_args", "attached-args", "attachedargs":
 			fields.AttachedArgs = value
 			hasFields = true
@@ -120,6 +125,9 @@ func FormatAttachmentFields(fields *AttachmentFields) string {
 	if fields.AttachedAt != "" {
 		lines = append(lines, "attached_at: "+fields.AttachedAt)
 	}
+	if fields.HookedAt != "" {
+		lines = append(lines, "hooked_at: "+fields.HookedAt)
+	}
 	if fields.AttachedArgs != "" {
 		lines = append(lines, "attached_args: "+fields.AttachedArgs)
 	}
@@ -166,6 +174,9 @@ func SetAttachmentFields(issue *Issue, fields *AttachmentFields) string {
 		"attached_at":       true,
 		"attached-at":       true,
 		"attachedat":        true,
+		"hooked_at":         true,
+		"hooked-at":         true,
+		"hookedat":          true,
 		"attached_args":     true,
 		"attached-args":     true,
 		"attachedargs":      true,
@@ -250,6 +261,9 @@ type ConvoyFields struct {
 	Molecule   string // Associated molecule/swarm ID
 	Merge      string // Merge strategy
 	BaseBranch string // Target branch for polecats (e.g., "feat/extraction-review")
+
+	PinnedState string // Operator-pinned work state (e.g., "active"), see gt convoy pin
+	PinnedUntil string // RFC3339 time the pinned state expires
 }
 
 // ParseConvoyFields extracts convoy fields from an issue's description.
@@ -295,6 +309,12 @@ func ParseConvoyFields(issue *Issue) *ConvoyFields {
 		case "base_branch", "base-branch", "basebranch":
 			fields.BaseBranch = value
 			hasFields = true
+		case "pinned_state":
+			fields.PinnedState = value
+			hasFields = true
+		case "pinned_until":
+			fields.PinnedUntil = value
+			hasFields = true
 		}
 	}
 
@@ -343,6 +363,12 @@ func FormatConvoyFields(fields *ConvoyFields) string {
 	if fields.BaseBranch != "" {
 		lines = append(lines, "base_branch: "+fields.BaseBranch)
 	}
+	if fields.PinnedState != "" {
+		lines = append(lines, "pinned_state: "+fields.PinnedState)
+	}
+	if fields.PinnedUntil != "" {
+		lines = append(lines, "pinned_until: "+fields.PinnedUntil)
+	}
 
 	return strings.Join(lines, "\n")
 }
@@ -381,13 +407,15 @@ func SetConvoyFields(issue *Issue, fields *ConvoyFields) string {
 
 	// Known convoy field keys (lowercase)
 	convoyKeys := map[string]bool{
-		"owner":       true,
-		"notify":      true,
-		"merge":       true,
-		"molecule":    true,
-		"base_branch": true,
-		"base-branch": true,
-		"basebranch":  true,
+		"owner":        true,
+		"notify":       true,
+		"merge":        true,
+		"molecule":     true,
+		"base_branch":  true,
+		"base-branch":  true,
+		"basebranch":   true,
+		"pinned_state": true,
+		"pinned_until": true,
 	}
 
 	// Collect non-convoy lines from existing description
@@ -462,18 +490,42 @@ type MRFields struct {
 	PreVerified     bool   // Polecat ran full gates after rebasing onto target
 	PreVerifiedAt   string // ISO 8601 timestamp when verification completed
 	PreVerifiedBase string // Target branch SHA at verification time
+
+	// DiffStat is a changed-files summary for reviewers and triage
+	// (e.g., "3 files changed, +10 -2").
+	DiffStat string
+
+	// Profile is the agent profile the worker ran under (GT_PROFILE, else
+	// GT_AGENT), for correlating MR outcomes with profiles.
+	Profile string
+
+	// PRURL is an externally-created pull request for the branch, linked
+	// with gt done --link-pr.
+	PRURL string
+
+	// Remote is the git remote the branch was pushed to (gt done --remote
+	// or the rig's push_remote). Empty means origin.
+	Remote string
 }
 
 // ParseMRFields extracts structured merge-request fields from an issue's description.
 // Fields are expected as "key: value" lines, with optional prose text mixed in.
 // Returns nil if no MR fields are found.
 func ParseMRFields(issue *Issue) *MRFields {
+	fields, _ := parseMRFields(issue)
+	return fields
+}
+
+// parseMRFields does the work for ParseMRFields and ParseMRFieldsStrict. It
+// also returns the values it had to ignore, for the strict variant to report.
+func parseMRFields(issue *Issue) (*MRFields, []string) {
 	if issue == nil || issue.Description == "" {
-		return nil
+		return nil, nil
 	}
 
 	fields := &MRFields{}
 	hasFields := false
+	var problems []string
 
 	for _, line := range strings.Split(issue.Description, "\n") {
 		line = strings.TrimSpace(line)
@@ -524,6 +576,9 @@ func ParseMRFields(issue *Issue) *MRFields {
 				fields.RetryCount = n
 				hasFields = true
 			}
+			if n, err := strconv.Atoi(value); value != "" && (err != nil || n < 0) {
+				problems = append(problems, fmt.Sprintf("invalid retry_count %q", value))
+			}
 		case "last_conflict_sha", "last-conflict-sha", "lastconflictsha":
 			fields.LastConflictSHA = value
 			hasFields = true
@@ -545,13 +600,34 @@ func ParseMRFields(issue *Issue) *MRFields {
 		case "pre_verified_base", "pre-verified-base", "preverifiedbase":
 			fields.PreVerifiedBase = value
 			hasFields = true
+		case "diffstat", "diff_stat", "diff-stat":
+			fields.DiffStat = value
+			hasFields = true
+		case "profile":
+			fields.Profile = value
+			hasFields = true
+		case "pr_url", "pr-url", "prurl":
+			fields.PRURL = value
+			hasFields = true
+		case "remote":
+			fields.Remote = value
+			hasFields = true
 		}
 	}
 
 	if !hasFields {
-		return nil
+		return nil, problems
 	}
-	return fields
+	return fields, problems
+}
+
+// PushRemote returns the remote the MR branch was pushed to, defaulting to
+// origin when none was recorded.
+func (f *MRFields) PushRemote() string {
+	if f.Remote != "" {
+		return f.Remote
+	}
+	return "origin"
 }
 
 // parseIntField parses an integer from a string, returning 0 on error.
@@ -561,6 +637,32 @@ func parseIntField(s string) (int, error) {
 	return n, err
 }
 
+// ParseMRFieldsStrict is ParseMRFields for callers that need to know why a
+// merge-request description is unusable. It returns an error when the issue
+// has no MR fields, lacks a branch or target, or has a retry_count that is
+// not a non-negative integer (ParseMRFields silently ignores such values).
+// When some fields were found, they are returned alongside the error.
+func ParseMRFieldsStrict(issue *Issue) (*MRFields, error) {
+	fields, invalid := parseMRFields(issue)
+	if fields == nil {
+		return nil, fmt.Errorf("no merge-request fields in description")
+	}
+
+	var problems []string
+	if fields.Branch == "" {
+		problems = append(problems, "missing branch")
+	}
+	if fields.Target == "" {
+		problems = append(problems, "missing target")
+	}
+	problems = append(problems, invalid...)
+
+	if len(problems) > 0 {
+		return fields, fmt.Errorf("malformed merge-request fields: %s", strings.Join(problems, "; "))
+	}
+	return fields, nil
+}
+
 // FormatMRFields formats MRFields as a string suitable for an issue description.
 // Only non-empty fields are included.
 func FormatMRFields(fields *MRFields) string {
@@ -618,6 +720,18 @@ func FormatMRFields(fields *MRFields) string {
 	if fields.PreVerifiedBase != "" {
 		lines = append(lines, "pre_verified_base: "+fields.PreVerifiedBase)
 	}
+	if fields.DiffStat != "" {
+		lines = append(lines, "diffstat: "+fields.DiffStat)
+	}
+	if fields.Profile != "" {
+		lines = append(lines, "profile: "+fields.Profile)
+	}
+	if fields.PRURL != "" {
+		lines = append(lines, "pr_url: "+fields.PRURL)
+	}
+	if fields.Remote != "" {
+		lines = append(lines, "remote: "+fields.Remote)
+	}
 
 	return strings.Join(lines, "\n")
 }
@@ -673,6 +787,14 @@ func SetMRFields(issue *Issue, fields *MRFields) string {
 		"pre_verified_base":  true,
 		"pre-verified-base":  true,
 		"preverifiedbase":    true,
+		"diffstat":           true,
+		"diff_stat":          true,
+		"diff-stat":          true,
+		"profile":            true,
+		"pr_url":             true,
+		"pr-url":             true,
+		"prurl":              true,
+		"remote":             true,
 	}
 
 	// Collect non-MR lines from existing description
@@ -227,6 +227,25 @@ func TestConvoyFieldsRoundTrip(t *testing.T) {
 	}
 }
 
+func TestAttachmentFieldsHookedAtRoundTrip(t *testing.T) {
+	original := &AttachmentFields{DispatchedBy: "mayor/", HookedAt: "2026-03-01T14:00:00Z"}
+	formatted := FormatAttachmentFields(original)
+	if !strings.Contains(formatted, "hooked_at: 2026-03-01T14:00:00Z") {
+		t.Errorf("FormatAttachmentFields missing hooked_at, got:\n%s", formatted)
+	}
+
+	// A re-sling replaces the old hooked_at rather than adding a second one.
+	issue := &Issue{Description: "Fix it.\n\n" + formatted}
+	desc := SetAttachmentFields(issue, &AttachmentFields{DispatchedBy: "mayor/", HookedAt: "2026-03-02T08:00:00Z"})
+	if strings.Count(desc, "hooked_at:") != 1 {
+		t.Errorf("expected a single hooked_at line:\n%s", desc)
+	}
+	parsed := ParseAttachmentFields(&Issue{Description: desc})
+	if parsed == nil || parsed.HookedAt != "2026-03-02T08:00:00Z" {
+		t.Errorf("HookedAt after update = %+v", parsed)
+	}
+}
+
 func TestConvoyOwnedFalseNotFormatted(t *testing.T) {
 	fields := &AttachmentFields{
 		ConvoyID:    "hq-cv-xyz",
@@ -291,6 +310,11 @@ func TestFormatConvoyFields(t *testing.T) {
 			fields: &ConvoyFields{Merge: "mr"},
 			want:   "Merge: mr",
 		},
+		{
+			name:   "pinned state",
+			fields: &ConvoyFields{Owner: "mayor/", PinnedState: "active", PinnedUntil: "2026-01-01T12:00:00Z"},
+			want:   "Owner: mayor/\npinned_state: active\npinned_until: 2026-01-01T12:00:00Z",
+		},
 	}
 
 	for _, tt := range tests {
@@ -328,6 +352,12 @@ func TestSetConvoyFields(t *testing.T) {
 			fields: &ConvoyFields{Owner: "mayor/", Merge: "direct"},
 			want:   "Convoy tracking 3 issues\nOwner: mayor/\nMerge: direct",
 		},
+		{
+			name:   "pins and clears pinned state",
+			issue:  &Issue{Description: "Long build\nOwner: mayor/\npinned_state: active\npinned_until: 2026-01-01T12:00:00Z"},
+			fields: &ConvoyFields{Owner: "mayor/"},
+			want:   "Long build\nOwner: mayor/",
+		},
 		{
 			name:   "empty fields removes field lines",
 			issue:  &Issue{Description: "Convoy tracking 3 issues\nOwner: mayor/\nMerge: direct"},
@@ -521,13 +551,31 @@ func TestAgentFieldsCompletionOmittedWhenEmpty(t *testing.T) {
 	}
 
 	formatted := FormatAgentDescription("Polecat nux", fields)
-	for _, keyword := range []string{"exit_type:", "mr_id:", "branch:", "mr_failed:", "completion_time:"} {
+	for _, keyword := range []string{"exit_type:", "mr_id:", "branch:", "mr_failed:", "completion_time:", "resume_note:"} {
 		if strings.Contains(formatted, keyword) {
 			t.Errorf("empty completion field %q should not appear in output:\n%s", keyword, formatted)
 		}
 	}
 }
 
+func TestAgentFieldsResumeNoteRoundTrip(t *testing.T) {
+	fields := &AgentFields{
+		RoleType:   "polecat",
+		Rig:        "gastown",
+		AgentState: "idle",
+		ExitType:   "DEFERRED",
+		ResumeNote: "parser done; next: wire flags (see TODO in cmd/x.go)",
+	}
+
+	formatted := FormatAgentDescription("Polecat nux", fields)
+	if !strings.Contains(formatted, "resume_note: parser done; next: wire flags") {
+		t.Errorf("missing resume_note in formatted output:\n%s", formatted)
+	}
+	if got := ParseAgentFields(formatted).ResumeNote; got != fields.ResumeNote {
+		t.Errorf("ResumeNote = %q, want %q", got, fields.ResumeNote)
+	}
+}
+
 func TestParseAgentFields_WithCompletionMetadata(t *testing.T) {
 	desc := "role_type: polecat\nrig: gastown\nagent_state: done\nhook_bead: gt-abc\nexit_type: ESCALATED\nbranch: polecat/nux/gt-abc@hash\nmr_failed: true\ncompletion_time: 2026-02-28T02:00:00Z"
 	got := ParseAgentFields(desc)
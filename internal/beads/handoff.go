@@ -263,6 +263,42 @@ func (b *Beads) GetAttachment(pinnedBeadID string) (*AttachmentFields, error) {
 	return ParseAttachmentFields(issue), nil
 }
 
+// SetDispatchedBy records which agent dispatched work on an issue, so that
+// gt done can notify the dispatcher on completion. Other attachment fields
+// and free-form description text are preserved.
+// Uses advisory file locking to prevent concurrent read-modify-write races.
+func (b *Beads) SetDispatchedBy(issueID, dispatcher string) error {
+	if dispatcher == "" {
+		return fmt.Errorf("dispatcher is required")
+	}
+
+	unlock, err := b.lockBead(issueID)
+	if err != nil {
+		return fmt.Errorf("acquiring bead lock: %w", err)
+	}
+	defer unlock()
+
+	issue, err := b.Show(issueID)
+	if err != nil {
+		return fmt.Errorf("fetching issue: %w", err)
+	}
+
+	fields := ParseAttachmentFields(issue)
+	if fields == nil {
+		fields = &AttachmentFields{}
+	}
+	if fields.DispatchedBy == dispatcher {
+		return nil // Already recorded
+	}
+	fields.DispatchedBy = dispatcher
+
+	newDesc := SetAttachmentFields(issue, fields)
+	if err := b.Update(issueID, UpdateOptions{Description: &newDesc}); err != nil {
+		return fmt.Errorf("updating issue: %w", err)
+	}
+	return nil
+}
+
 // currentTimestamp returns the current time in ISO 8601 format.
 func currentTimestamp() string {
 	return time.Now().UTC().Format(time.RFC3339)
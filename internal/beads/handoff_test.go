@@ -1,6 +1,7 @@
 package beads
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -65,3 +66,52 @@ func TestClearMailResultZeroValues(t *testing.T) {
 		t.Errorf("expected zero values, got Closed=%d Cleared=%d", result.Closed, result.Cleared)
 	}
 }
+
+func TestSetDispatchedBy_RoundTrip(t *testing.T) {
+	store := installStatefulMockBD(t)
+	store.add(mockBead{
+		ID:          "gt-abc",
+		Status:      "hooked",
+		Description: "Fix the widget.\n\nattached_molecule: gt-wisp-1",
+	})
+
+	bd := newMockBeads(t)
+	if err := bd.SetDispatchedBy("gt-abc", "mayor/"); err != nil {
+		t.Fatalf("SetDispatchedBy: %v", err)
+	}
+
+	fields, err := bd.GetAttachment("gt-abc")
+	if err != nil {
+		t.Fatalf("GetAttachment: %v", err)
+	}
+	if fields == nil || fields.DispatchedBy != "mayor/" {
+		t.Fatalf("DispatchedBy = %+v, want mayor/", fields)
+	}
+	if fields.AttachedMolecule != "gt-wisp-1" {
+		t.Errorf("AttachedMolecule = %q, want existing field preserved", fields.AttachedMolecule)
+	}
+	if desc := store.get("gt-abc", "description"); !strings.Contains(desc, "Fix the widget.") {
+		t.Errorf("free-form description lost: %q", desc)
+	}
+
+	// Re-dispatching by another agent overwrites the previous dispatcher.
+	if err := bd.SetDispatchedBy("gt-abc", "gastown/crew/max"); err != nil {
+		t.Fatalf("SetDispatchedBy (second): %v", err)
+	}
+	fields, _ = bd.GetAttachment("gt-abc")
+	if fields.DispatchedBy != "gastown/crew/max" {
+		t.Errorf("DispatchedBy = %q, want gastown/crew/max", fields.DispatchedBy)
+	}
+}
+
+func TestSetDispatchedBy_Errors(t *testing.T) {
+	installStatefulMockBD(t)
+	bd := newMockBeads(t)
+
+	if err := bd.SetDispatchedBy("gt-abc", ""); err == nil {
+		t.Error("expected error for empty dispatcher")
+	}
+	if err := bd.SetDispatchedBy("gt-missing", "mayor/"); err == nil {
+		t.Error("expected error for missing issue")
+	}
+}
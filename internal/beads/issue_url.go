@@ -0,0 +1,74 @@
+package beads
+
+import (
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// IssueURL returns a browser link for an issue, built from the issue_url
+// template in the owning rig's beads config (mayor/rigs.json). The rig is
+// found by routing the issue's prefix. Returns false when the issue's rig
+// can't be resolved or has no web-backed issues.
+func IssueURL(townRoot, issueID string) (string, bool) {
+	id := ExtractIssueID(issueID)
+	rigName := GetRigNameForPrefix(townRoot, ExtractPrefix(id))
+	if rigName == "" {
+		return "", false
+	}
+
+	rigsConfig, err := config.LoadRigsConfig(constants.MayorRigsPath(townRoot))
+	if err != nil {
+		return "", false
+	}
+	entry, ok := rigsConfig.Rigs[rigName]
+	if !ok || entry.BeadsConfig == nil || entry.BeadsConfig.IssueURL == "" {
+		return "", false
+	}
+
+	tmpl := entry.BeadsConfig.IssueURL
+	if strings.Contains(tmpl, "{repo}") {
+		repo, ok := repoWebURL(entry.GitURL)
+		if !ok {
+			return "", false
+		}
+		tmpl = strings.ReplaceAll(tmpl, "{repo}", repo)
+	}
+	return strings.ReplaceAll(tmpl, "{id}", id), true
+}
+
+// repoWebURL converts a git remote URL (https, ssh://, or scp-style
+// git@host:owner/repo) to the repository's https web URL. Local paths and
+// file:// URLs have no web URL.
+func repoWebURL(gitURL string) (string, bool) {
+	u := strings.TrimSpace(gitURL)
+	switch {
+	case strings.HasPrefix(u, "https://"), strings.HasPrefix(u, "http://"):
+		u = u[strings.Index(u, "://")+3:]
+	case strings.HasPrefix(u, "ssh://"):
+		u = strings.TrimPrefix(u, "ssh://")
+	case strings.Contains(u, "@") && strings.Contains(u, ":") && !strings.Contains(u, "://"):
+		// scp-style: git@github.com:owner/repo.git
+		u = strings.Replace(u, ":", "/", 1)
+	default:
+		return "", false
+	}
+
+	// Drop userinfo and any port on the host.
+	if at := strings.Index(u, "@"); at >= 0 && at < strings.Index(u, "/") {
+		u = u[at+1:]
+	}
+	host, path, ok := strings.Cut(u, "/")
+	if !ok || host == "" {
+		return "", false
+	}
+	if colon := strings.Index(host, ":"); colon >= 0 {
+		host = host[:colon]
+	}
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	if path == "" {
+		return "", false
+	}
+	return "https://" + host + "/" + path, true
+}
@@ -0,0 +1,91 @@
+package beads
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+func TestIssueURL(t *testing.T) {
+	townRoot := t.TempDir()
+	beadsDir := filepath.Join(townRoot, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	routesContent := `{"prefix": "gt-", "path": "gastown/mayor/rig"}
+{"prefix": "wi-", "path": "widgets/mayor/rig"}
+{"prefix": "lo-", "path": "localonly/mayor/rig"}
+{"prefix": "hq-", "path": "."}
+`
+	if err := os.WriteFile(filepath.Join(beadsDir, "routes.jsonl"), []byte(routesContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	rigs := &config.RigsConfig{
+		Version: 1,
+		Rigs: map[string]config.RigEntry{
+			"gastown": {
+				GitURL:      "git@github.com:steveyegge/gastown.git",
+				BeadsConfig: &config.BeadsConfig{Prefix: "gt", IssueURL: "{repo}/issues?q={id}"},
+			},
+			"widgets": {
+				GitURL:      "https://example.com/acme/widgets.git",
+				BeadsConfig: &config.BeadsConfig{Prefix: "wi", IssueURL: "https://tracker.example.com/browse/{id}"},
+			},
+			"localonly": {
+				GitURL:      "/srv/repos/localonly",
+				BeadsConfig: &config.BeadsConfig{Prefix: "lo"},
+			},
+		},
+	}
+	if err := config.SaveRigsConfig(constants.MayorRigsPath(townRoot), rigs); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		issueID string
+		want    string
+		wantOK  bool
+	}{
+		{"github-backed rig", "gt-abc12", "https://github.com/steveyegge/gastown/issues?q=gt-abc12", true},
+		{"external wrapper", "external:gt:gt-abc12", "https://github.com/steveyegge/gastown/issues?q=gt-abc12", true},
+		{"template without repo", "wi-7", "https://tracker.example.com/browse/wi-7", true},
+		{"non-web rig", "lo-xyz", "", false},
+		{"town-level bead", "hq-cv-abc", "", false},
+		{"unrouted prefix", "zz-123", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := IssueURL(townRoot, tt.issueID)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("IssueURL(%q) = (%q, %v), want (%q, %v)", tt.issueID, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestRepoWebURL(t *testing.T) {
+	tests := []struct {
+		gitURL string
+		want   string
+		wantOK bool
+	}{
+		{"https://github.com/steveyegge/gastown.git", "https://github.com/steveyegge/gastown", true},
+		{"https://github.com/steveyegge/gastown", "https://github.com/steveyegge/gastown", true},
+		{"git@github.com:steveyegge/gastown.git", "https://github.com/steveyegge/gastown", true},
+		{"ssh://git@gitlab.example.com:2222/team/app.git", "https://gitlab.example.com/team/app", true},
+		{"/srv/repos/localonly", "", false},
+		{"file:///srv/repos/localonly", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := repoWebURL(tt.gitURL)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("repoWebURL(%q) = (%q, %v), want (%q, %v)", tt.gitURL, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package beads
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// mockBeadStore is a file-backed fake bd for round-trip tests. Each bead is a
+// set of files under dir (<id>.status, <id>.description, ...), and the mock
+// bd script installed on PATH reads and writes them for show/update/close.
+type mockBeadStore struct {
+	t   *testing.T
+	dir string
+}
+
+// mockBead seeds a bead in the mock store.
+type mockBead struct {
+	ID          string
+	Title       string
+	Status      string
+	Type        string
+	Assignee    string
+	Description string
+	Labels      []string
+}
+
+// statefulMockBDScript implements the subset of bd used by the Beads
+// wrapper's read-modify-write helpers. Unknown commands succeed silently.
+const statefulMockBDScript = `#!/bin/sh
+S="$MOCK_BD_STATE"
+cmd=""
+id=""
+for arg in "$@"; do
+  case "$arg" in
+    --*) ;;
+    *)
+      if [ -z "$cmd" ]; then cmd="$arg"
+      elif [ -z "$id" ]; then id="$arg"; fi
+      ;;
+  esac
+done
+
+esc() {
+  awk 'BEGIN{ORS=""} { gsub(/\\/,"\\\\"); gsub(/"/,"\\\""); gsub(/\t/,"\\t"); if (NR>1) print "\\n"; print }'
+}
+
+field() {
+  if [ -f "$S/$1.$2" ]; then esc < "$S/$1.$2"; fi
+}
+
+labels() {
+  first=1
+  printf '['
+  if [ -f "$S/$1.labels" ]; then
+    while IFS= read -r l; do
+      [ -z "$l" ] && continue
+      [ $first -eq 0 ] && printf ','
+      printf '"%s"' "$(printf '%s' "$l" | esc)"
+      first=0
+    done < "$S/$1.labels"
+  fi
+  printf ']'
+}
+
+case "$cmd" in
+  version)
+    exit 0
+    ;;
+  show)
+    printf '['
+    sep=""
+    for arg in "$@"; do
+      case "$arg" in --*|show) continue ;; esac
+      if [ ! -f "$S/$arg.status" ]; then
+        echo "Error: no issue found matching \"$arg\"" >&2
+        exit 1
+      fi
+      printf '%s{"id":"%s","title":"%s","status":"%s","issue_type":"%s","assignee":"%s","description":"%s","labels":%s}' \
+        "$sep" "$arg" "$(field "$arg" title)" "$(field "$arg" status)" "$(field "$arg" type)" \
+        "$(field "$arg" assignee)" "$(field "$arg" description)" "$(labels "$arg")"
+      sep=","
+    done
+    printf ']\n'
+    exit 0
+    ;;
+  update)
+    if [ ! -f "$S/$id.status" ]; then
+      echo "Error: no issue found matching \"$id\"" >&2
+      exit 1
+    fi
+    for arg in "$@"; do
+      case "$arg" in
+        --description=*) printf '%s' "${arg#--description=}" > "$S/$id.description" ;;
+        --status=*) printf '%s' "${arg#--status=}" > "$S/$id.status" ;;
+        --title=*) printf '%s' "${arg#--title=}" > "$S/$id.title" ;;
+        --assignee=*) printf '%s' "${arg#--assignee=}" > "$S/$id.assignee" ;;
+        --add-label=*) printf '%s\n' "${arg#--add-label=}" >> "$S/$id.labels" ;;
+        --remove-label=*)
+          if [ -f "$S/$id.labels" ]; then
+            grep -vxF "${arg#--remove-label=}" "$S/$id.labels" > "$S/$id.labels.tmp"
+            mv "$S/$id.labels.tmp" "$S/$id.labels"
+          fi
+          ;;
+      esac
+    done
+    echo "$*" >> "$S/calls.log"
+    exit 0
+    ;;
+  close|reopen)
+    new="closed"
+    [ "$cmd" = "reopen" ] && new="open"
+    for arg in "$@"; do
+      case "$arg" in --*|close|reopen) continue ;; esac
+      [ -f "$S/$arg.status" ] && printf '%s' "$new" > "$S/$arg.status"
+    done
+    echo "$*" >> "$S/calls.log"
+    exit 0
+    ;;
+  *)
+    exit 0
+    ;;
+esac
+`
+
+// installStatefulMockBD puts a file-backed fake bd on PATH and returns a
+// handle for seeding and inspecting beads. Skips on Windows.
+func installStatefulMockBD(t *testing.T) *mockBeadStore {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("stateful mock bd requires sh")
+	}
+
+	binDir := t.TempDir()
+	stateDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(statefulMockBDScript), 0755); err != nil {
+		t.Fatalf("write mock bd: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("MOCK_BD_STATE", stateDir)
+
+	return &mockBeadStore{t: t, dir: stateDir}
+}
+
+// add seeds a bead. Status defaults to "open".
+func (s *mockBeadStore) add(b mockBead) {
+	s.t.Helper()
+	if b.Status == "" {
+		b.Status = "open"
+	}
+	files := map[string]string{
+		"status":      b.Status,
+		"title":       b.Title,
+		"type":        b.Type,
+		"assignee":    b.Assignee,
+		"description": b.Description,
+	}
+	if len(b.Labels) > 0 {
+		files["labels"] = strings.Join(b.Labels, "\n") + "\n"
+	}
+	for suffix, content := range files {
+		path := filepath.Join(s.dir, b.ID+"."+suffix)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			s.t.Fatalf("seed %s: %v", path, err)
+		}
+	}
+}
+
+// get returns a stored field of a bead ("" if unset).
+func (s *mockBeadStore) get(id, field string) string {
+	s.t.Helper()
+	data, err := os.ReadFile(filepath.Join(s.dir, id+"."+field))
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// newMockBeads returns an isolated Beads wrapper rooted in a temp workspace.
+func newMockBeads(t *testing.T) *Beads {
+	t.Helper()
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".beads"), 0755); err != nil {
+		t.Fatalf("mkdir .beads: %v", err)
+	}
+	return NewIsolated(tmpDir)
+}
@@ -0,0 +1,71 @@
+package beads
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// timeSpentKey is the description field that accumulates how long agents
+// have worked on an issue across attempts (written by gt done).
+const timeSpentKey = "time_spent"
+
+// ParseTimeSpent returns the time_spent recorded in an issue description,
+// or zero if the field is absent or unparseable.
+func ParseTimeSpent(description string) time.Duration {
+	for _, line := range strings.Split(description, "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), ":")
+		if !ok || !strings.EqualFold(strings.TrimSpace(key), timeSpentKey) {
+			continue
+		}
+		if d, err := time.ParseDuration(strings.TrimSpace(value)); err == nil {
+			return d
+		}
+		return 0
+	}
+	return 0
+}
+
+// setTimeSpent returns description with its time_spent line set to d,
+// replacing an existing line in place or appending one.
+func setTimeSpent(description string, d time.Duration) string {
+	entry := fmt.Sprintf("%s: %s", timeSpentKey, d.Round(time.Second))
+	lines := strings.Split(description, "\n")
+	for i, line := range lines {
+		key, _, ok := strings.Cut(strings.TrimSpace(line), ":")
+		if ok && strings.EqualFold(strings.TrimSpace(key), timeSpentKey) {
+			lines[i] = entry
+			return strings.Join(lines, "\n")
+		}
+	}
+	if strings.TrimSpace(description) == "" {
+		return entry
+	}
+	return strings.TrimRight(description, "\n") + "\n" + entry
+}
+
+// AddTimeSpent adds d to the time_spent field of an issue, creating the
+// field if needed. Uses the per-bead lock so concurrent callers accumulate
+// rather than overwrite each other.
+func (b *Beads) AddTimeSpent(issueID string, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	unlock, err := b.lockBead(issueID)
+	if err != nil {
+		return fmt.Errorf("acquiring bead lock: %w", err)
+	}
+	defer unlock()
+
+	issue, err := b.Show(issueID)
+	if err != nil {
+		return fmt.Errorf("fetching issue: %w", err)
+	}
+
+	newDesc := setTimeSpent(issue.Description, ParseTimeSpent(issue.Description)+d)
+	if err := b.Update(issueID, UpdateOptions{Description: &newDesc}); err != nil {
+		return fmt.Errorf("updating issue: %w", err)
+	}
+	return nil
+}
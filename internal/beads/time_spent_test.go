@@ -0,0 +1,81 @@
+package beads
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseTimeSpent(t *testing.T) {
+	tests := []struct {
+		desc string
+		want time.Duration
+	}{
+		{"", 0},
+		{"Fix the widget.", 0},
+		{"Fix the widget.\ntime_spent: 1h30m0s", 90 * time.Minute},
+		{"time_spent: 45s\nattached_molecule: gt-wisp-1", 45 * time.Second},
+		{"time_spent: soon", 0},
+	}
+	for _, tt := range tests {
+		if got := ParseTimeSpent(tt.desc); got != tt.want {
+			t.Errorf("ParseTimeSpent(%q) = %s, want %s", tt.desc, got, tt.want)
+		}
+	}
+}
+
+func TestSetTimeSpent(t *testing.T) {
+	if got := setTimeSpent("", time.Minute); got != "time_spent: 1m0s" {
+		t.Errorf("empty description: got %q", got)
+	}
+	if got := setTimeSpent("Fix it.\n", time.Minute); got != "Fix it.\ntime_spent: 1m0s" {
+		t.Errorf("append: got %q", got)
+	}
+	got := setTimeSpent("Fix it.\ntime_spent: 1m0s\ndispatched_by: mayor/", 3*time.Minute)
+	if got != "Fix it.\ntime_spent: 3m0s\ndispatched_by: mayor/" {
+		t.Errorf("replace in place: got %q", got)
+	}
+}
+
+func TestAddTimeSpent_Accumulates(t *testing.T) {
+	store := installStatefulMockBD(t)
+	store.add(mockBead{
+		ID:          "gt-abc",
+		Status:      "hooked",
+		Description: "Fix the widget.\n\ndispatched_by: mayor/",
+	})
+	bd := newMockBeads(t)
+
+	// Three gt done calls on the same issue, e.g. after an escalation and
+	// a deferral.
+	for _, d := range []time.Duration{20 * time.Minute, 45 * time.Minute, 90 * time.Second} {
+		if err := bd.AddTimeSpent("gt-abc", d); err != nil {
+			t.Fatalf("AddTimeSpent(%s): %v", d, err)
+		}
+	}
+
+	desc := store.get("gt-abc", "description")
+	want := 20*time.Minute + 45*time.Minute + 90*time.Second
+	if got := ParseTimeSpent(desc); got != want {
+		t.Errorf("time_spent = %s, want %s\n%s", got, want, desc)
+	}
+	if strings.Count(desc, "time_spent:") != 1 {
+		t.Errorf("expected a single time_spent line:\n%s", desc)
+	}
+	if !strings.Contains(desc, "Fix the widget.") || !strings.Contains(desc, "dispatched_by: mayor/") {
+		t.Errorf("existing description lost:\n%s", desc)
+	}
+}
+
+func TestAddTimeSpent_Errors(t *testing.T) {
+	installStatefulMockBD(t)
+	bd := newMockBeads(t)
+
+	if err := bd.AddTimeSpent("gt-missing", time.Minute); err == nil {
+		t.Error("expected error for missing issue")
+	}
+	// Non-positive durations are a no-op and never touch bd.
+	if err := bd.AddTimeSpent("gt-missing", 0); err != nil {
+		t.Errorf("zero duration should be a no-op, got %v", err)
+	}
+}
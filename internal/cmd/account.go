@@ -19,6 +19,7 @@ var (
 	accountJSON        bool
 	accountEmail       string
 	accountDescription string
+	accountProvider    string
 )
 
 var accountCmd = &cobra.Command{
@@ -35,7 +36,8 @@ Commands:
   gt account list              List registered accounts
   gt account add <handle>      Add a new account
   gt account default <handle>  Set the default account
-  gt account status            Show current account info`,
+  gt account status            Show current account info
+  gt account test <handle>     Verify an account can start the agent`,
 }
 
 var accountListCmd = &cobra.Command{
@@ -63,7 +65,8 @@ that directory to complete the login.
 Examples:
   gt account add work
   gt account add work --email steve@company.com
-  gt account add work --email steve@company.com --desc "Work account"`,
+  gt account add work --email steve@company.com --desc "Work account"
+  gt account add cloud --provider bedrock`,
 	Args: cobra.ExactArgs(1),
 	RunE: runAccountAdd,
 }
@@ -89,6 +92,7 @@ type AccountListItem struct {
 	Email       string `json:"email"`
 	Description string `json:"description,omitempty"`
 	ConfigDir   string `json:"config_dir"`
+	Provider    string `json:"provider"`
 	IsDefault   bool   `json:"is_default"`
 }
 
@@ -123,6 +127,7 @@ func runAccountList(cmd *cobra.Command, args []string) error {
 			Email:       acct.Email,
 			Description: acct.Description,
 			ConfigDir:   acct.ConfigDir,
+			Provider:    acct.ProviderName(),
 			IsDefault:   handle == cfg.Default,
 		})
 	}
@@ -150,6 +155,9 @@ func runAccountList(cmd *cobra.Command, args []string) error {
 		if item.Email != "" {
 			fmt.Printf("  %s", item.Email)
 		}
+		if item.Provider != config.DefaultAccountProvider {
+			fmt.Printf("  %s", style.Dim.Render("["+item.Provider+"]"))
+		}
 		if item.IsDefault {
 			fmt.Printf("  %s", style.Dim.Render("(default)"))
 		}
@@ -207,6 +215,7 @@ func runAccountAdd(cmd *cobra.Command, args []string) error {
 		Email:       accountEmail,
 		Description: accountDescription,
 		ConfigDir:   configDir,
+		Provider:    accountProvider,
 	}
 
 	// If this is the first account, make it default
@@ -509,6 +518,7 @@ func init() {
 
 	accountAddCmd.Flags().StringVar(&accountEmail, "email", "", "Account email address")
 	accountAddCmd.Flags().StringVar(&accountDescription, "desc", "", "Account description")
+	accountAddCmd.Flags().StringVar(&accountProvider, "provider", "", "API provider for the account (default: anthropic)")
 
 	// Add subcommands
 	accountCmd.AddCommand(accountListCmd)
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/quota"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/util"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var accountTestJSON bool
+
+var accountTestCmd = &cobra.Command{
+	Use:   "test <handle>",
+	Short: "Verify an account can start the agent",
+	Long: `Verify that an account authenticates before relying on it.
+
+Runs Claude Code once in print mode with the account's config directory
+and a trivial prompt, then classifies the result with the same detector
+gt quota scan uses (including rate_limit_patterns from accounts.json):
+
+  ok            Agent started and exited cleanly
+  auth_error    Account is not logged in or its credentials are invalid
+  rate_limited  Account is rate-limited or out of quota
+  failed        Agent failed for some other reason
+
+Exits non-zero unless the result is ok. The probe sends one short prompt,
+so it uses a small amount of the account's quota.
+
+Examples:
+  gt account test work
+  gt account test work --json`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runAccountTest,
+}
+
+func init() {
+	accountTestCmd.Flags().BoolVar(&accountTestJSON, "json", false, "Output as JSON")
+	accountCmd.AddCommand(accountTestCmd)
+}
+
+// accountProbeTimeout bounds how long gt account test waits for the agent.
+const accountProbeTimeout = 90 * time.Second
+
+// accountProbeCommand is the agent binary and arguments for the probe.
+// Tests override this variable with a fake agent.
+var accountProbeCommand = []string{"claude", "-p", "Reply with just: OK", "--max-turns", "1"}
+
+// AccountTestResult is the JSON output of gt account test.
+type AccountTestResult struct {
+	Handle string `json:"handle"`
+	quota.ProbeResult
+}
+
+func runAccountTest(cmd *cobra.Command, args []string) error {
+	handle := args[0]
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	acctCfg, err := config.LoadAccountsConfig(constants.MayorAccountsPath(townRoot))
+	if err != nil {
+		return fmt.Errorf("loading accounts config: %w", err)
+	}
+	acct := acctCfg.GetAccount(handle)
+	if acct == nil {
+		return fmt.Errorf("account '%s' not found. Available accounts: %v", handle, accountHandles(acctCfg))
+	}
+
+	scanner, err := newQuotaScanner(nil, acctCfg)
+	if err != nil {
+		return err
+	}
+
+	result := AccountTestResult{
+		Handle:      handle,
+		ProbeResult: probeAccount(scanner, util.ExpandHome(acct.ConfigDir), accountProbeTimeout),
+	}
+
+	if accountTestJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(result); err != nil {
+			return err
+		}
+	} else {
+		printAccountTestResult(result)
+	}
+
+	if result.Outcome != quota.ProbeOK {
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+// probeAccount runs accountProbeCommand with CLAUDE_CONFIG_DIR set to
+// configDir and classifies its output.
+func probeAccount(scanner *quota.Scanner, configDir string, timeout time.Duration) quota.ProbeResult {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	c := exec.CommandContext(ctx, accountProbeCommand[0], accountProbeCommand[1:]...) //nolint:gosec // G204: fixed agent command
+	c.Env = append(os.Environ(), "CLAUDE_CONFIG_DIR="+configDir)
+	out, err := c.CombinedOutput()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return quota.ProbeResult{Outcome: quota.ProbeFailed, MatchedLine: fmt.Sprintf("timed out after %s", timeout)}
+	}
+	return scanner.ClassifyProbe(string(out), err)
+}
+
+func printAccountTestResult(r AccountTestResult) {
+	switch r.Outcome {
+	case quota.ProbeOK:
+		fmt.Printf("%s Account %s: agent started cleanly\n", style.Success.Render("✓"), r.Handle)
+		return
+	case quota.ProbeAuthError:
+		fmt.Printf("%s Account %s: authentication failed\n", style.Error.Render("✗"), r.Handle)
+	case quota.ProbeRateLimited:
+		fmt.Printf("%s Account %s: rate-limited\n", style.Error.Render("✗"), r.Handle)
+		if r.ResetsAt != "" {
+			fmt.Printf("  Resets: %s\n", r.ResetsAt)
+		}
+	case quota.ProbeQuotaExhausted:
+		fmt.Printf("%s Account %s: quota exhausted (check billing)\n", style.Error.Render("✗"), r.Handle)
+	default:
+		fmt.Printf("%s Account %s: agent failed\n", style.Error.Render("✗"), r.Handle)
+	}
+	if r.MatchedLine != "" {
+		fmt.Printf("  %s\n", style.Dim.Render(r.MatchedLine))
+	}
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/quota"
+)
+
+// fakeAgent writes a shell script that prints output and exits with code,
+// and points accountProbeCommand at it.
+func fakeAgent(t *testing.T, output string, code int) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("fake agent is a shell script")
+	}
+	script := filepath.Join(t.TempDir(), "agent")
+	body := fmt.Sprintf("#!/bin/sh\ncat <<'OUT'\n%s\nOUT\nexit %d\n", output, code)
+	if err := os.WriteFile(script, []byte(body), 0755); err != nil {
+		t.Fatal(err)
+	}
+	orig := accountProbeCommand
+	accountProbeCommand = []string{script}
+	t.Cleanup(func() { accountProbeCommand = orig })
+}
+
+func TestProbeAccount(t *testing.T) {
+	scanner, err := quota.NewScanner(nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		output string
+		code   int
+		want   quota.ProbeOutcome
+	}{
+		{"success", "OK", 0, quota.ProbeOK},
+		{"auth error", "Invalid API key · Please run /login", 1, quota.ProbeAuthError},
+		{"rate limit", "You've hit your limit · resets 7pm (America/Los_Angeles)", 1, quota.ProbeRateLimited},
+		{"other failure", "segmentation fault", 2, quota.ProbeFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeAgent(t, tt.output, tt.code)
+			got := probeAccount(scanner, t.TempDir(), 10*time.Second)
+			if got.Outcome != tt.want {
+				t.Errorf("Outcome = %s (%q), want %s", got.Outcome, got.MatchedLine, tt.want)
+			}
+		})
+	}
+}
+
+func TestProbeAccount_SetsConfigDir(t *testing.T) {
+	fakeAgent(t, "", 0)
+	configDir := t.TempDir()
+	// Fail unless the agent sees the account's config dir.
+	script := accountProbeCommand[0]
+	body := "#!/bin/sh\n[ \"$CLAUDE_CONFIG_DIR\" = \"" + configDir + "\" ] || { echo \"Not logged in\"; exit 1; }\necho OK\n"
+	if err := os.WriteFile(script, []byte(body), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	scanner, _ := quota.NewScanner(nil, nil, nil)
+	if got := probeAccount(scanner, configDir, 10*time.Second); got.Outcome != quota.ProbeOK {
+		t.Errorf("Outcome = %s (%q), want ok", got.Outcome, got.MatchedLine)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/workspace"
@@ -165,6 +166,11 @@ func runAssign(_ *cobra.Command, args []string) error {
 	rigBeadsDir := filepath.Join(townRoot, rigName, ".beads")
 	updateAgentHookBead(agentID, beadID, rigBeadsDir, townBeadsDir)
 
+	// Record the dispatcher so completion notifications reach the assigner.
+	if err := beads.New(townRoot).SetDispatchedBy(beadID, detectSender()); err != nil {
+		fmt.Fprintf(os.Stderr, "%s Warning: failed to record dispatcher: %v\n", style.Dim.Render("⚠"), err)
+	}
+
 	// Step 4: Log event
 	if err := events.LogFeed(events.TypeHook, agentID, events.HookPayload(beadID)); err != nil {
 		fmt.Fprintf(os.Stderr, "%s Warning: failed to log event: %v\n", style.Dim.Render("⚠"), err)
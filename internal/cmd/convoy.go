@@ -19,10 +19,12 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	convoyops "github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/tui/convoy"
+	"github.com/steveyegge/gastown/internal/tui/feed"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -81,6 +83,7 @@ var (
 	convoyListJSON     bool
 	convoyListStatus   string
 	convoyListAll      bool
+	convoyListArchived bool
 	convoyListTree     bool
 	convoyInteractive  bool
 	convoyStrandedJSON bool
@@ -248,12 +251,14 @@ Without an ID, shows status of all active convoys.`,
 var convoyListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List convoys",
-	Long: `List convoys, showing open convoys by default.
+	Long: `List convoys, showing open convoys by default. Convoys archived with
+'gt convoy archive' are hidden unless --archived is given.
 
 Examples:
   gt convoy list              # Open convoys only (default)
   gt convoy list --all        # All convoys (open + closed)
   gt convoy list --status=closed  # Recently landed
+  gt convoy list --all --archived # Include convoys retired by gt convoy archive
   gt convoy list --tree       # Show convoy + child status tree
   gt convoy list --json`,
 	SilenceUsage: true,
@@ -382,6 +387,7 @@ func init() {
 	convoyListCmd.Flags().BoolVar(&convoyListJSON, "json", false, "Output as JSON")
 	convoyListCmd.Flags().StringVar(&convoyListStatus, "status", "", "Filter by status (open, closed)")
 	convoyListCmd.Flags().BoolVar(&convoyListAll, "all", false, "Show all convoys (open and closed)")
+	convoyListCmd.Flags().BoolVar(&convoyListArchived, "archived", false, "Include archived convoys (see gt convoy archive)")
 	convoyListCmd.Flags().BoolVar(&convoyListTree, "tree", false, "Show convoy + child status tree")
 
 	// Interactive TUI flag (on parent command)
@@ -414,6 +420,11 @@ func init() {
 	convoyCmd.AddCommand(convoyLandCmd)
 	convoyCmd.AddCommand(convoyStageCmd)
 	convoyCmd.AddCommand(convoyLaunchCmd)
+	convoyCmd.AddCommand(convoyReassignCmd)
+	convoyCmd.AddCommand(convoyShowCmd)
+	convoyCmd.AddCommand(convoyPinCmd)
+	convoyCmd.AddCommand(convoyUnpinCmd)
+	convoyCmd.AddCommand(convoyArchiveCmd)
 
 	rootCmd.AddCommand(convoyCmd)
 }
@@ -1002,6 +1013,8 @@ func runConvoyClose(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("%s Closed convoy 🚚 %s: %s\n", style.Bold.Render("✓"), convoyID, convoy.Title)
+	_ = events.LogFeed(events.TypeConvoyClosed, detectActor(),
+		events.ConvoyClosedPayload(convoyID, convoy.Title, reason, convoyCloseForce))
 	if convoyCloseReason != "" {
 		fmt.Printf("  Reason: %s\n", convoyCloseReason)
 	}
@@ -1751,26 +1764,28 @@ func runConvoyStatus(cmd *cobra.Command, args []string) error {
 			lifecycle = "caller-managed"
 		}
 		type jsonStatus struct {
-			ID            string             `json:"id"`
-			Title         string             `json:"title"`
-			Status        string             `json:"status"`
-			Owned         bool               `json:"owned"`
-			Lifecycle     string             `json:"lifecycle"`
-			MergeStrategy string             `json:"merge_strategy,omitempty"`
-			Tracked       []trackedIssueInfo `json:"tracked"`
-			Completed     int                `json:"completed"`
-			Total         int                `json:"total"`
+			ID              string             `json:"id"`
+			Title           string             `json:"title"`
+			Status          string             `json:"status"`
+			Owned           bool               `json:"owned"`
+			Lifecycle       string             `json:"lifecycle"`
+			MergeStrategy   string             `json:"merge_strategy,omitempty"`
+			Tracked         []trackedIssueInfo `json:"tracked"`
+			Completed       int                `json:"completed"`
+			Total           int                `json:"total"`
+			ProgressPercent float64            `json:"progress_percent"`
 		}
 		out := jsonStatus{
-			ID:            convoy.ID,
-			Title:         convoy.Title,
-			Status:        convoy.Status,
-			Owned:         isOwned,
-			Lifecycle:     lifecycle,
-			MergeStrategy: convoyMergeFromFields(convoy.Description),
-			Tracked:       tracked,
-			Completed:     completed,
-			Total:         len(tracked),
+			ID:              convoy.ID,
+			Title:           convoy.Title,
+			Status:          convoy.Status,
+			Owned:           isOwned,
+			Lifecycle:       lifecycle,
+			MergeStrategy:   convoyMergeFromFields(convoy.Description),
+			Tracked:         tracked,
+			Completed:       completed,
+			Total:           len(tracked),
+			ProgressPercent: convoyProgress(completed, len(tracked)),
 		}
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
@@ -1912,17 +1927,27 @@ func runConvoyList(cmd *cobra.Command, args []string) error {
 	if err := json.Unmarshal(out, &convoys); err != nil {
 		return fmt.Errorf("parsing convoy list: %w", err)
 	}
+	if !convoyListArchived {
+		kept := convoys[:0]
+		for _, c := range convoys {
+			if !convoyops.IsArchived(c.Labels) {
+				kept = append(kept, c)
+			}
+		}
+		convoys = kept
+	}
 
 	if convoyListJSON {
 		// Enrich each convoy with tracked issues and completion counts
 		type convoyListEntry struct {
-			ID        string             `json:"id"`
-			Title     string             `json:"title"`
-			Status    string             `json:"status"`
-			CreatedAt string             `json:"created_at"`
-			Tracked   []trackedIssueInfo `json:"tracked"`
-			Completed int                `json:"completed"`
-			Total     int                `json:"total"`
+			ID              string             `json:"id"`
+			Title           string             `json:"title"`
+			Status          string             `json:"status"`
+			CreatedAt       string             `json:"created_at"`
+			Tracked         []trackedIssueInfo `json:"tracked"`
+			Completed       int                `json:"completed"`
+			Total           int                `json:"total"`
+			ProgressPercent float64            `json:"progress_percent"`
 		}
 		enriched := make([]convoyListEntry, 0, len(convoys))
 		for _, c := range convoys {
@@ -1941,13 +1966,14 @@ func runConvoyList(cmd *cobra.Command, args []string) error {
 				}
 			}
 			enriched = append(enriched, convoyListEntry{
-				ID:        c.ID,
-				Title:     c.Title,
-				Status:    c.Status,
-				CreatedAt: c.CreatedAt,
-				Tracked:   tracked,
-				Completed: completed,
-				Total:     len(tracked),
+				ID:              c.ID,
+				Title:           c.Title,
+				Status:          c.Status,
+				CreatedAt:       c.CreatedAt,
+				Tracked:         tracked,
+				Completed:       completed,
+				Total:           len(tracked),
+				ProgressPercent: convoyProgress(completed, len(tracked)),
 			})
 		}
 		enc := json.NewEncoder(os.Stdout)
@@ -2133,6 +2159,12 @@ func applyFreshIssueDetails(dep *trackedDependency, details *issueDetails) {
 	dep.Labels = details.Labels
 }
 
+// convoyProgress is the percentage of tracked issues completed, computed the
+// same way as the feed's Convoy.Progress so every convoy JSON output agrees.
+func convoyProgress(completed, total int) float64 {
+	return feed.Convoy{Completed: completed, Total: total}.Progress()
+}
+
 // getTrackedIssues gets issues tracked by a convoy with fresh cross-rig details.
 // Returns issue details including status, type, and worker info.
 //
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var (
+	convoyArchiveOlderThan string
+	convoyArchiveDryRun    bool
+)
+
+var convoyArchiveCmd = &cobra.Command{
+	Use:   "archive --older-than <duration>",
+	Short: "Archive convoys that closed long ago",
+	Long: `Archive closed convoys whose close time is older than --older-than.
+
+Archiving labels the convoy bead gt:archived. Archived convoys are kept
+for history (gt convoy show still works) but are left out of gt convoy
+list and the feed's landed section. Use 'gt convoy list --archived' to
+include them.
+
+--older-than accepts a Go duration or a number of days (e.g. 30d).
+
+Examples:
+  gt convoy archive --older-than 30d
+  gt convoy archive --older-than 7d --dry-run`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runConvoyArchive,
+}
+
+func init() {
+	convoyArchiveCmd.Flags().StringVar(&convoyArchiveOlderThan, "older-than", "", "Archive convoys closed longer ago than this (e.g. 30d, 720h)")
+	convoyArchiveCmd.Flags().BoolVarP(&convoyArchiveDryRun, "dry-run", "n", false, "Show what would be archived without archiving")
+	_ = convoyArchiveCmd.MarkFlagRequired("older-than")
+}
+
+func runConvoyArchive(cmd *cobra.Command, args []string) error {
+	olderThan, err := parseDuration(convoyArchiveOlderThan)
+	if err != nil || olderThan <= 0 {
+		return fmt.Errorf("invalid --older-than %q: expected a positive duration (e.g. 30d, 720h)", convoyArchiveOlderThan)
+	}
+
+	townBeads, err := getTownBeadsDir()
+	if err != nil {
+		return err
+	}
+
+	ids, err := archiveOldConvoys(townBeads, olderThan, time.Now(), convoyArchiveDryRun)
+	if len(ids) == 0 && err == nil {
+		fmt.Printf("%s No closed convoys older than %s\n", style.Dim.Render("○"), convoyArchiveOlderThan)
+		return nil
+	}
+
+	verb := "Archived"
+	if convoyArchiveDryRun {
+		verb = "Would archive"
+	}
+	for _, id := range ids {
+		fmt.Printf("  %s %s\n", style.Dim.Render("·"), id)
+	}
+	if len(ids) > 0 {
+		fmt.Printf("%s %s %d convoy(s)\n", style.Bold.Render("✓"), verb, len(ids))
+	}
+	return err
+}
+
+// archiveOldConvoys labels closed convoys that closed before now-olderThan
+// as archived and returns their IDs. With dryRun it only returns the IDs.
+// On a failed update it returns the IDs archived so far and the error.
+func archiveOldConvoys(townBeads string, olderThan time.Duration, now time.Time, dryRun bool) ([]string, error) {
+	out, err := runBdJSON(townBeads, "list", "--type=convoy", "--status=closed", "--limit=0", "--json", "--flat")
+	if err != nil {
+		return nil, fmt.Errorf("listing closed convoys: %w", err)
+	}
+
+	var convoys []struct {
+		ID       string   `json:"id"`
+		Status   string   `json:"status"`
+		ClosedAt string   `json:"closed_at"`
+		Labels   []string `json:"labels"`
+	}
+	if err := json.Unmarshal(out, &convoys); err != nil {
+		return nil, fmt.Errorf("parsing convoy list: %w", err)
+	}
+
+	cutoff := now.Add(-olderThan)
+	var archived []string
+	for _, c := range convoys {
+		closedAt, _ := beads.ParseTime(c.ClosedAt)
+		if !convoy.ShouldArchive(c.Status, closedAt, c.Labels, cutoff) {
+			continue
+		}
+		if !dryRun {
+			if err := BdCmd("update", c.ID, "--add-label="+convoy.ArchivedLabel).
+				Dir(townBeads).
+				WithAutoCommit().
+				Run(); err != nil {
+				return archived, fmt.Errorf("archiving convoy %s: %w", c.ID, err)
+			}
+		}
+		archived = append(archived, c.ID)
+	}
+	return archived, nil
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/convoy"
+)
+
+// mockBdForConvoyArchive installs a fake bd whose list prints listJSON and
+// whose update calls are appended to the returned log file.
+func mockBdForConvoyArchive(t *testing.T, listJSON string) (townBeads, updateLog string) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("mock bd requires sh")
+	}
+
+	binDir := t.TempDir()
+	townBeads = filepath.Join(t.TempDir(), ".beads")
+	if err := os.MkdirAll(townBeads, 0755); err != nil {
+		t.Fatal(err)
+	}
+	updateLog = filepath.Join(binDir, "bd-update.log")
+
+	script := `#!/bin/sh
+cmd=""
+for arg in "$@"; do
+  case "$arg" in
+    --*) ;;
+    *) cmd="$arg"; break ;;
+  esac
+done
+case "$cmd" in
+  list) echo '` + listJSON + `' ;;
+  update) echo "$@" >> "` + updateLog + `" ;;
+esac
+exit 0
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return townBeads, updateLog
+}
+
+func readUpdateLog(t *testing.T, path string) []string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Split(strings.TrimSpace(string(data)), "\n")
+}
+
+func TestArchiveOldConvoys(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	closed := func(id string, ago time.Duration, labels string) string {
+		return `{"id":"` + id + `","status":"closed","closed_at":"` + now.Add(-ago).Format(time.RFC3339) + `","labels":[` + labels + `]}`
+	}
+	listJSON := "[" + strings.Join([]string{
+		closed("hq-cv-old", 40*24*time.Hour, ""),
+		closed("hq-cv-recent", 2*24*time.Hour, ""),
+		closed("hq-cv-done", 60*24*time.Hour, `"`+convoy.ArchivedLabel+`"`),
+		`{"id":"hq-cv-nodate","status":"closed"}`,
+		// bd can emit timestamps without the T separator.
+		`{"id":"hq-cv-spaced","status":"closed","closed_at":"` + now.Add(-45*24*time.Hour).Format("2006-01-02 15:04:05") + `"}`,
+	}, ",") + "]"
+
+	t.Run("archives by age", func(t *testing.T) {
+		townBeads, updateLog := mockBdForConvoyArchive(t, listJSON)
+
+		ids, err := archiveOldConvoys(townBeads, 30*24*time.Hour, now, false)
+		if err != nil {
+			t.Fatalf("archiveOldConvoys: %v", err)
+		}
+		if !slices.Equal(ids, []string{"hq-cv-old", "hq-cv-spaced"}) {
+			t.Errorf("archived = %v, want [hq-cv-old hq-cv-spaced]", ids)
+		}
+		updates := readUpdateLog(t, updateLog)
+		if len(updates) != 2 || !strings.Contains(updates[0], "hq-cv-old") || !strings.Contains(updates[0], "--add-label="+convoy.ArchivedLabel) {
+			t.Errorf("bd updates = %q, want archive labels on hq-cv-old and hq-cv-spaced", updates)
+		}
+	})
+
+	t.Run("shorter age archives more", func(t *testing.T) {
+		townBeads, _ := mockBdForConvoyArchive(t, listJSON)
+
+		ids, err := archiveOldConvoys(townBeads, 24*time.Hour, now, false)
+		if err != nil {
+			t.Fatalf("archiveOldConvoys: %v", err)
+		}
+		if !slices.Equal(ids, []string{"hq-cv-old", "hq-cv-recent", "hq-cv-spaced"}) {
+			t.Errorf("archived = %v, want [hq-cv-old hq-cv-recent hq-cv-spaced]", ids)
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		townBeads, updateLog := mockBdForConvoyArchive(t, listJSON)
+
+		ids, err := archiveOldConvoys(townBeads, 30*24*time.Hour, now, true)
+		if err != nil {
+			t.Fatalf("archiveOldConvoys: %v", err)
+		}
+		if !slices.Equal(ids, []string{"hq-cv-old", "hq-cv-spaced"}) {
+			t.Errorf("would archive = %v, want [hq-cv-old hq-cv-spaced]", ids)
+		}
+		if updates := readUpdateLog(t, updateLog); len(updates) != 0 {
+			t.Errorf("dry run ran bd update: %q", updates)
+		}
+	})
+}
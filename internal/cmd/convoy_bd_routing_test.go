@@ -147,6 +147,9 @@ esac
 	if !strings.Contains(out, `"id": "hq-cv-town"`) {
 		t.Fatalf("expected convoy JSON output, got:\n%s", out)
 	}
+	if !strings.Contains(out, `"progress_percent": 0`) {
+		t.Errorf("expected progress_percent in convoy JSON, got:\n%s", out)
+	}
 }
 
 func TestRunConvoyStatus_UsesTownRootAndStripsBeadsDir(t *testing.T) {
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// resetConvoyCloseFlags restores convoy close flag globals after a test.
+func resetConvoyCloseFlags(t *testing.T) {
+	t.Helper()
+	oldForce, oldReason, oldNotify := convoyCloseForce, convoyCloseReason, convoyCloseNotify
+	t.Cleanup(func() {
+		convoyCloseForce, convoyCloseReason, convoyCloseNotify = oldForce, oldReason, oldNotify
+	})
+	convoyCloseForce, convoyCloseReason, convoyCloseNotify = false, "", ""
+}
+
+func TestRunConvoyClose_CompleteConvoyCloses(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub requires sh")
+	}
+	resetConvoyCloseFlags(t)
+
+	townRoot, logPath := newTestDAG(t).
+		Convoy("hq-cv-done", "Finished work").
+		Task("gt-a", "Task A", "gastown").TrackedBy("hq-cv-done").WithStatus("closed").
+		Setup(t)
+
+	if err := runConvoyClose(nil, []string{"hq-cv-done"}); err != nil {
+		t.Fatalf("runConvoyClose() error: %v", err)
+	}
+
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("reading bd log: %v", err)
+	}
+	if !strings.Contains(string(data), "CMD:close hq-cv-done") {
+		t.Errorf("expected bd close for convoy, log:\n%s", data)
+	}
+
+	events, err := os.ReadFile(filepath.Join(townRoot, ".events.jsonl"))
+	if err != nil {
+		t.Fatalf("reading events: %v", err)
+	}
+	if !strings.Contains(string(events), `"type":"convoy_closed"`) ||
+		!strings.Contains(string(events), `"convoy":"hq-cv-done"`) {
+		t.Errorf("expected convoy_closed event, got:\n%s", events)
+	}
+}
+
+func TestRunConvoyClose_PartialConvoyRefusesWithoutForce(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub requires sh")
+	}
+	resetConvoyCloseFlags(t)
+
+	_, logPath := newTestDAG(t).
+		Convoy("hq-cv-part", "Half done").
+		Task("gt-b", "Task B", "gastown").TrackedBy("hq-cv-part").WithStatus("in_progress").
+		Setup(t)
+
+	err := runConvoyClose(nil, []string{"hq-cv-part"})
+	if err == nil || !strings.Contains(err.Error(), "open issue") {
+		t.Fatalf("runConvoyClose() error = %v, want open-issue refusal", err)
+	}
+	data, _ := os.ReadFile(logPath)
+	if strings.Contains(string(data), "CMD:close") {
+		t.Errorf("convoy should not be closed without --force, log:\n%s", data)
+	}
+
+	convoyCloseForce = true
+	if err := runConvoyClose(nil, []string{"hq-cv-part"}); err != nil {
+		t.Fatalf("runConvoyClose() with --force error: %v", err)
+	}
+	data, _ = os.ReadFile(logPath)
+	if !strings.Contains(string(data), "CMD:close hq-cv-part -r Force closed") {
+		t.Errorf("expected forced bd close, log:\n%s", data)
+	}
+}
@@ -102,7 +102,7 @@ func bdUpdateStatus(beadID, status string) error {
 	return nil
 }
 
-// collectBlockedRigsInDAG returns a map of parked/docked rig names to the
+// collectBlockedRigsInDAG returns a map of parked/docked/draining rig names to the
 // bead IDs that target them. Only considers slingable nodes. (gt-4owfd.1)
 func collectBlockedRigsInDAG(dag *ConvoyDAG, townRoot string) map[string][]string {
 	blockedRigBeads := make(map[string][]string)
@@ -113,14 +113,14 @@ func collectBlockedRigsInDAG(dag *ConvoyDAG, townRoot string) map[string][]strin
 		if node.Rig == "" {
 			continue
 		}
-		if blocked, _ := IsRigParkedOrDocked(townRoot, node.Rig); blocked {
+		if blocked, _ := IsRigDispatchBlocked(townRoot, node.Rig); blocked {
 			blockedRigBeads[node.Rig] = append(blockedRigBeads[node.Rig], node.ID)
 		}
 	}
 	return blockedRigBeads
 }
 
-// checkBlockedRigsForLaunch checks if any target rigs are parked or docked.
+// checkBlockedRigsForLaunch checks if any target rigs are parked, docked, or draining.
 // Returns an error listing all blocked rigs if any are found and force is false.
 // (gt-4owfd.1)
 func checkBlockedRigsForLaunch(dag *ConvoyDAG, townRoot string, force bool) error {
@@ -151,7 +151,7 @@ func checkBlockedRigsForLaunch(dag *ConvoyDAG, townRoot string, force bool) erro
 		details = append(details, fmt.Sprintf("  %s: %s", rig, strings.Join(beadIDs, ", ")))
 	}
 
-	return fmt.Errorf("cannot launch: %d target rig(s) are parked or docked:\n%s\n\nUse 'gt rig unpark' or 'gt rig undock' to restore, or --force to proceed anyway",
+	return fmt.Errorf("cannot launch: %d target rig(s) are parked, docked, or draining:\n%s\n\nUse 'gt rig unpark', 'gt rig undock', or 'gt rig undrain' to restore, or --force to proceed anyway",
 		len(rigs), strings.Join(details, "\n"))
 }
 
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// convoyPinUntil is when the pin expires: an RFC3339 time or a duration from now.
+var convoyPinUntil string
+
+var convoyPinCmd = &cobra.Command{
+	Use:   "pin <convoy-id> <state> --until <time>",
+	Short: "Pin a convoy's displayed work state until a time",
+	Long: `Pin a convoy's work state, overriding the state derived from its
+tracked issues until the pin expires.
+
+Use this when you know a convoy is fine despite how it looks, for
+example a long legitimate build that would otherwise show as stuck.
+The pin is stored on the convoy bead, so gt feed, gt convoy show, and
+the deacon's stuck-convoy nudges all respect it. Once --until passes,
+the computed state applies again. A convoy whose tracked issues are all
+closed always shows as complete.
+
+States: active, idle, waiting, stuck
+
+--until accepts an RFC3339 time or a duration from now.
+
+Examples:
+  gt convoy pin hq-cv-abc active --until 2h
+  gt convoy pin hq-cv-abc active --until 2026-01-02T09:00:00Z
+  gt convoy unpin hq-cv-abc`,
+	Args:         cobra.ExactArgs(2),
+	SilenceUsage: true,
+	RunE:         runConvoyPin,
+}
+
+var convoyUnpinCmd = &cobra.Command{
+	Use:   "unpin <convoy-id>",
+	Short: "Remove a convoy's pinned work state",
+	Long: `Remove a pin set with 'gt convoy pin', so the convoy's work state is
+derived from its tracked issues again.
+
+Examples:
+  gt convoy unpin hq-cv-abc`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runConvoyUnpin,
+}
+
+func init() {
+	convoyPinCmd.Flags().StringVar(&convoyPinUntil, "until", "", "When the pin expires (RFC3339 time or duration, e.g. 2h)")
+	_ = convoyPinCmd.MarkFlagRequired("until")
+}
+
+func runConvoyPin(cmd *cobra.Command, args []string) error {
+	convoyID := args[0]
+
+	state, ok := convoy.ParsePinnableState(args[1])
+	if !ok {
+		names := make([]string, len(convoy.PinnableStates))
+		for i, st := range convoy.PinnableStates {
+			names[i] = string(st)
+		}
+		return fmt.Errorf("invalid state %q: must be one of %s", args[1], strings.Join(names, ", "))
+	}
+
+	until, err := parsePinUntil(convoyPinUntil, time.Now())
+	if err != nil {
+		return err
+	}
+
+	err = updateConvoyPin(convoyID, func(f *beads.ConvoyFields) {
+		f.PinnedState = string(state)
+		f.PinnedUntil = until.UTC().Format(time.RFC3339)
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s Pinned convoy %s as %s until %s\n", style.Bold.Render("✓"), convoyID, state, until.Local().Format("2006-01-02 15:04 MST"))
+	return nil
+}
+
+func runConvoyUnpin(cmd *cobra.Command, args []string) error {
+	convoyID := args[0]
+
+	err := updateConvoyPin(convoyID, func(f *beads.ConvoyFields) {
+		f.PinnedState = ""
+		f.PinnedUntil = ""
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s Unpinned convoy %s\n", style.Bold.Render("✓"), convoyID)
+	return nil
+}
+
+// parsePinUntil parses a --until value, either an RFC3339 time or a positive
+// duration from now. The result must be in the future.
+func parsePinUntil(s string, now time.Time) (time.Time, error) {
+	until, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		d, derr := time.ParseDuration(s)
+		if derr != nil {
+			return time.Time{}, fmt.Errorf("invalid --until %q: expected RFC3339 time or duration (e.g. 2h)", s)
+		}
+		until = now.Add(d)
+	}
+	if !until.After(now) {
+		return time.Time{}, fmt.Errorf("invalid --until %q: must be in the future", s)
+	}
+	return until, nil
+}
+
+// updateConvoyPin applies set to a convoy's description fields and writes
+// the description back to the convoy bead, holding the bead lock so a
+// concurrent update to the same convoy is not lost.
+func updateConvoyPin(convoyID string, set func(*beads.ConvoyFields)) error {
+	townBeads, err := getTownBeadsDir()
+	if err != nil {
+		return err
+	}
+
+	err = beads.NewWithBeadsDir(filepath.Dir(townBeads), townBeads).UpdateConvoyFields(convoyID, set)
+	if errors.Is(err, beads.ErrNotFound) {
+		return fmt.Errorf("convoy '%s' not found", convoyID)
+	}
+	if err != nil {
+		return fmt.Errorf("updating convoy %s: %w", convoyID, err)
+	}
+	return nil
+}
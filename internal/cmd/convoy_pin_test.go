@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParsePinUntil(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		in      string
+		want    time.Time
+		wantErr bool
+	}{
+		{in: "2h", want: now.Add(2 * time.Hour)},
+		{in: "2026-01-02T09:00:00Z", want: time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC)},
+		{in: "-1h", wantErr: true},
+		{in: "2025-12-31T09:00:00Z", wantErr: true},
+		{in: "tomorrow", wantErr: true},
+		{in: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parsePinUntil(tt.in, now)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parsePinUntil(%q) = %v, want error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("parsePinUntil(%q) = %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+	}
+}
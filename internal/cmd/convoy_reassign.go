@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// convoyReassignTo is the polecat (<rig>/<polecat>) that receives the work.
+var convoyReassignTo string
+
+// notifyReassignedWorker mails the new worker about the issues handed to it.
+// Mail delivery nudges the recipient's session. Tests override this variable
+// to avoid spawning gt.
+var notifyReassignedWorker = func(addr, convoyID string, issueIDs []string) error {
+	subject := fmt.Sprintf("🚚 Convoy %s: %d issue(s) reassigned to you", convoyID, len(issueIDs))
+	body := fmt.Sprintf("The previous worker on convoy %s is gone. These issues are now assigned to you:\n\n  %s\n\nRun 'gt hook' to pick up the work.",
+		convoyID, strings.Join(issueIDs, "\n  "))
+	cmd := exec.Command("gt", "mail", "send", addr, "-s", subject, "-m", body)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("gt mail send %s: %w\nstderr: %s", addr, err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+var convoyReassignCmd = &cobra.Command{
+	Use:   "reassign <convoy-id> --to <rig>/<polecat>",
+	Short: "Reassign a convoy's open issues to another polecat",
+	Long: `Reassign the open tracked issues of a convoy to another polecat.
+
+Use this when a convoy's worker has died and its issues are orphaned.
+Every tracked issue that is not closed gets its assignee set to the new
+polecat; closed issues are skipped. The new worker is then sent mail
+(which nudges its session) listing the issues it now owns.
+
+Examples:
+  gt convoy reassign hq-cv-abc --to gastown/nux
+  gt convoy reassign hq-cv-abc --to gastown/polecats/nux`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runConvoyReassign,
+}
+
+func init() {
+	convoyReassignCmd.Flags().StringVar(&convoyReassignTo, "to", "", "Polecat to receive the work (<rig>/<polecat>)")
+	_ = convoyReassignCmd.MarkFlagRequired("to")
+}
+
+func runConvoyReassign(cmd *cobra.Command, args []string) error {
+	convoyID := args[0]
+
+	assignee, err := parseReassignTarget(convoyReassignTo)
+	if err != nil {
+		return err
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	if info, err := os.Stat(filepath.Join(townRoot, assignee)); err != nil || !info.IsDir() {
+		return fmt.Errorf("polecat %s not found", assignee)
+	}
+
+	townBeads, err := getTownBeadsDir()
+	if err != nil {
+		return err
+	}
+
+	tracked, err := getTrackedIssues(townBeads, convoyID)
+	if err != nil {
+		return err
+	}
+	if len(tracked) == 0 {
+		return fmt.Errorf("convoy '%s' has no tracked issues", convoyID)
+	}
+
+	var reassigned []string
+	var failed int
+	for _, t := range tracked {
+		switch {
+		case t.Status == "closed" || t.Status == "tombstone":
+			fmt.Printf("  %s %s: %s\n", style.Dim.Render("○"), t.ID, style.Dim.Render("already "+t.Status))
+			continue
+		case t.Assignee == assignee:
+			fmt.Printf("  %s %s: %s\n", style.Dim.Render("○"), t.ID, style.Dim.Render("already assigned to "+assignee))
+			continue
+		}
+
+		if err := BdCmd("update", t.ID, "--assignee="+assignee).Dir(resolveBeadDir(t.ID)).Run(); err != nil {
+			style.PrintWarning("couldn't reassign %s: %v", t.ID, err)
+			failed++
+			continue
+		}
+		from := t.Assignee
+		if from == "" {
+			from = "unassigned"
+		}
+		fmt.Printf("  %s %s: %s → %s\n", style.Bold.Render("✓"), t.ID, from, assignee)
+		reassigned = append(reassigned, t.ID)
+	}
+
+	if len(reassigned) == 0 {
+		if failed > 0 {
+			return fmt.Errorf("failed to reassign %d issue(s)", failed)
+		}
+		fmt.Printf("%s Nothing to reassign on convoy %s\n", style.Dim.Render("○"), convoyID)
+		return nil
+	}
+
+	fmt.Printf("%s Reassigned %d issue(s) on convoy %s to %s\n", style.Bold.Render("✓"), len(reassigned), convoyID, assignee)
+
+	// Mail goes to the short <rig>/<polecat> address.
+	addr := strings.Replace(assignee, "/polecats/", "/", 1)
+	if err := notifyReassignedWorker(addr, convoyID, reassigned); err != nil {
+		style.PrintWarning("couldn't notify %s: %v", addr, err)
+	} else {
+		fmt.Printf("  Notified: %s\n", addr)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to reassign %d issue(s)", failed)
+	}
+	return nil
+}
+
+// parseReassignTarget converts a --to value of <rig>/<polecat> or
+// <rig>/polecats/<polecat> into the assignee form used on beads
+// (<rig>/polecats/<polecat>).
+func parseReassignTarget(spec string) (string, error) {
+	parts := strings.Split(strings.Trim(spec, "/"), "/")
+	if len(parts) == 3 && parts[1] == "polecats" {
+		parts = []string{parts[0], parts[2]}
+	}
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("invalid --to %q: expected <rig>/<polecat>", spec)
+	}
+	return parts[0] + "/polecats/" + parts[1], nil
+}
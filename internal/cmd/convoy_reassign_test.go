@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// stubReassignNotify replaces notifyReassignedWorker for a test and records
+// the issues each address was told about.
+func stubReassignNotify(t *testing.T) map[string][]string {
+	t.Helper()
+	sent := make(map[string][]string)
+	old := notifyReassignedWorker
+	t.Cleanup(func() { notifyReassignedWorker = old })
+	notifyReassignedWorker = func(addr, convoyID string, issueIDs []string) error {
+		sent[addr] = append(sent[addr], issueIDs...)
+		return nil
+	}
+	return sent
+}
+
+func TestRunConvoyReassign_ReassignsOpenAndSkipsClosed(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub requires sh")
+	}
+	oldTo := convoyReassignTo
+	t.Cleanup(func() { convoyReassignTo = oldTo })
+	sent := stubReassignNotify(t)
+
+	townRoot, logPath := newTestDAG(t).
+		Convoy("hq-cv-orph", "Orphaned work").
+		Task("gt-a", "Task A", "gastown").TrackedBy("hq-cv-orph").WithStatus("in_progress").
+		Task("gt-b", "Task B", "gastown").TrackedBy("hq-cv-orph").WithStatus("closed").
+		Task("gt-c", "Task C", "gastown").TrackedBy("hq-cv-orph").
+		Setup(t)
+	if err := os.MkdirAll(filepath.Join(townRoot, "gastown", "polecats", "nux"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	convoyReassignTo = "gastown/nux"
+	if err := runConvoyReassign(nil, []string{"hq-cv-orph"}); err != nil {
+		t.Fatalf("runConvoyReassign() error: %v", err)
+	}
+
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("reading bd log: %v", err)
+	}
+	log := string(data)
+	for _, id := range []string{"gt-a", "gt-c"} {
+		if !strings.Contains(log, "CMD:update "+id+" --assignee=gastown/polecats/nux") {
+			t.Errorf("expected %s to be reassigned, log:\n%s", id, log)
+		}
+	}
+	if strings.Contains(log, "CMD:update gt-b") {
+		t.Errorf("closed issue gt-b should not be reassigned, log:\n%s", log)
+	}
+
+	if want := map[string][]string{"gastown/nux": {"gt-a", "gt-c"}}; !reflect.DeepEqual(sent, want) {
+		t.Errorf("notified = %v, want %v", sent, want)
+	}
+}
+
+func TestRunConvoyReassign_AllClosedIsNoop(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub requires sh")
+	}
+	oldTo := convoyReassignTo
+	t.Cleanup(func() { convoyReassignTo = oldTo })
+	sent := stubReassignNotify(t)
+
+	townRoot, logPath := newTestDAG(t).
+		Convoy("hq-cv-done", "Finished work").
+		Task("gt-d", "Task D", "gastown").TrackedBy("hq-cv-done").WithStatus("closed").
+		Setup(t)
+	if err := os.MkdirAll(filepath.Join(townRoot, "gastown", "polecats", "nux"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	convoyReassignTo = "gastown/polecats/nux"
+	if err := runConvoyReassign(nil, []string{"hq-cv-done"}); err != nil {
+		t.Fatalf("runConvoyReassign() error: %v", err)
+	}
+	data, _ := os.ReadFile(logPath)
+	if strings.Contains(string(data), "CMD:update") {
+		t.Errorf("no issues should be updated, log:\n%s", data)
+	}
+	if len(sent) != 0 {
+		t.Errorf("nobody should be notified, got %v", sent)
+	}
+}
+
+func TestRunConvoyReassign_UnknownPolecat(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub requires sh")
+	}
+	oldTo := convoyReassignTo
+	t.Cleanup(func() { convoyReassignTo = oldTo })
+	stubReassignNotify(t)
+
+	newTestDAG(t).
+		Convoy("hq-cv-orph", "Orphaned work").
+		Task("gt-a", "Task A", "gastown").TrackedBy("hq-cv-orph").
+		Setup(t)
+
+	convoyReassignTo = "gastown/ghost"
+	err := runConvoyReassign(nil, []string{"hq-cv-orph"})
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("runConvoyReassign() error = %v, want polecat not found", err)
+	}
+}
+
+func TestParseReassignTarget(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    string
+		wantErr bool
+	}{
+		{spec: "gastown/nux", want: "gastown/polecats/nux"},
+		{spec: "gastown/polecats/nux", want: "gastown/polecats/nux"},
+		{spec: "gastown/nux/", want: "gastown/polecats/nux"},
+		{spec: "nux", wantErr: true},
+		{spec: "gastown/crew/max", wantErr: true},
+		{spec: "/nux", wantErr: true},
+		{spec: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseReassignTarget(tt.spec)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseReassignTarget(%q) error = %v, wantErr %v", tt.spec, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseReassignTarget(%q) = %q, want %q", tt.spec, got, tt.want)
+		}
+	}
+}
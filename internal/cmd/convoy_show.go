@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tui/feed"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var convoyShowJSON bool
+
+var convoyShowCmd = &cobra.Command{
+	Use:   "show <convoy-id>",
+	Short: "Show a convoy with full tracked-issue detail",
+	Long: `Show a convoy's work state, progress, and workers, followed by every
+tracked issue with its status, assignee, and last activity.
+
+Work state (active, waiting, stuck, idle) is derived the same way as in
+the gt feed convoy panel, so the two always agree.
+
+Examples:
+  gt convoy show hq-cv-abc
+  gt convoy show hq-cv-abc --json`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runConvoyShow,
+}
+
+func init() {
+	convoyShowCmd.Flags().BoolVar(&convoyShowJSON, "json", false, "Output as JSON")
+}
+
+func runConvoyShow(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	detail, err := feed.FetchConvoy(townRoot, args[0])
+	if err != nil {
+		return err
+	}
+
+	if convoyShowJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(detail)
+	}
+	printConvoyDetail(os.Stdout, detail, time.Now())
+	return nil
+}
+
+// printConvoyDetail writes the human-readable gt convoy show output.
+// Activity ages are measured from now.
+func printConvoyDetail(w io.Writer, d *feed.ConvoyDetail, now time.Time) {
+	c := d.Convoy
+	fmt.Fprintf(w, "🚚 %s %s\n\n", style.Bold.Render(c.ID+":"), c.Title)
+	fmt.Fprintf(w, "  Status:    %s\n", formatConvoyStatus(c.Status))
+	if c.WorkState != "" {
+		state := string(c.WorkState)
+		if c.StateReason != "" {
+			state += " " + style.Dim.Render("("+c.StateReason+")")
+		}
+		fmt.Fprintf(w, "  State:     %s\n", state)
+	}
+	fmt.Fprintf(w, "  Progress:  %d/%d completed (%.0f%%)\n", c.Completed, c.Total, c.Progress())
+	if len(c.Workers) > 0 {
+		fmt.Fprintf(w, "  Workers:   %s\n", strings.Join(c.Workers, ", "))
+	} else {
+		fmt.Fprintf(w, "  Workers:   %s\n", style.Dim.Render("none"))
+	}
+	if !c.LastActivity.IsZero() {
+		fmt.Fprintf(w, "  Activity:  %s ago\n", formatWorkerAge(now.Sub(c.LastActivity)))
+	}
+
+	if len(d.Issues) == 0 {
+		fmt.Fprintf(w, "\n  %s\n", style.Dim.Render("No tracked issues"))
+		return
+	}
+
+	fmt.Fprintf(w, "\n  %s\n", style.Bold.Render("Tracked Issues:"))
+	for _, t := range d.Issues {
+		// Status symbol: ✓ closed, ▶ in_progress/hooked, ○ other
+		symbol := "○"
+		switch t.Status {
+		case "closed":
+			symbol = "✓"
+		case "in_progress", "hooked":
+			symbol = "▶"
+		}
+
+		assignee := t.Assignee
+		if assignee == "" {
+			assignee = "unassigned"
+		}
+		line := fmt.Sprintf("    %s %s: %s [%s] %s", symbol, t.ID, t.Title, t.Status, assignee)
+		if !t.LastActivity.IsZero() {
+			line += "  " + style.Dim.Render(formatWorkerAge(now.Sub(t.LastActivity))+" ago")
+		}
+		fmt.Fprintln(w, line)
+	}
+}
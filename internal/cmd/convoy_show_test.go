@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/tui/feed"
+)
+
+func TestPrintConvoyDetail(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	d := &feed.ConvoyDetail{
+		Convoy: feed.Convoy{
+			ID:           "hq-cv-abc",
+			Title:        "Auth rework",
+			Status:       "open",
+			Completed:    1,
+			Total:        2,
+			WorkState:    convoy.WorkStateActive,
+			Workers:      []string{"gastown/polecats/toast"},
+			LastActivity: now.Add(-5 * time.Minute),
+		},
+		Issues: []feed.TrackedIssue{
+			{ID: "gt-a", Title: "Login", Status: "closed", Assignee: "gastown/polecats/nux"},
+			{ID: "gt-b", Title: "Logout", Status: "in_progress", Assignee: "gastown/polecats/toast", LastActivity: now.Add(-5 * time.Minute)},
+			{ID: "gt-c", Title: "Sessions", Status: "open"},
+		},
+	}
+
+	var buf bytes.Buffer
+	printConvoyDetail(&buf, d, now)
+	out := buf.String()
+
+	for _, want := range []string{
+		"hq-cv-abc:",
+		"Auth rework",
+		"State:     active",
+		"Progress:  1/2 completed (50%)",
+		"Workers:   gastown/polecats/toast",
+		"Activity:  5m ago",
+		"✓ gt-a: Login [closed] gastown/polecats/nux",
+		"▶ gt-b: Logout [in_progress] gastown/polecats/toast",
+		"○ gt-c: Sessions [open] unassigned",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestPrintConvoyDetail_NoIssues(t *testing.T) {
+	var buf bytes.Buffer
+	printConvoyDetail(&buf, &feed.ConvoyDetail{Convoy: feed.Convoy{ID: "hq-cv-empty", Status: "open"}}, time.Now())
+	out := buf.String()
+	if !strings.Contains(out, "No tracked issues") || !strings.Contains(out, "Workers:   none") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+}
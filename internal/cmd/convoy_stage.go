@@ -1786,14 +1786,14 @@ func detectOrphans(dag *ConvoyDAG, input *StageInput) []StagingFinding {
 	return findings
 }
 
-// isRigBlockedFn is a seam for tests. Production uses IsRigParkedOrDocked.
+// isRigBlockedFn is a seam for tests. Production uses IsRigDispatchBlocked.
 var isRigBlockedFn = func(townRoot, rigName string) (bool, string) {
-	return IsRigParkedOrDocked(townRoot, rigName)
+	return IsRigDispatchBlocked(townRoot, rigName)
 }
 
-// detectBlockedRigs warns about slingable nodes whose target rig is parked
-// or docked (gt-4owfd.1, #2120). Uses IsRigParkedOrDocked which checks both
-// wisp ephemeral state and persistent bead labels.
+// detectBlockedRigs warns about slingable nodes whose target rig is parked,
+// docked, or draining (gt-4owfd.1, #2120). Uses IsRigDispatchBlocked which
+// checks wisp ephemeral state and persistent bead labels.
 func detectBlockedRigs(dag *ConvoyDAG) []StagingFinding {
 	townRoot, err := workspace.FindFromCwd()
 	if err != nil {
@@ -1834,10 +1834,7 @@ func detectBlockedRigs(dag *ConvoyDAG) []StagingFinding {
 	for _, rigName := range rigNames {
 		info := blockedRigs[rigName]
 		sort.Strings(info.beadIDs)
-		undoCmd := "gt rig unpark"
-		if info.reason == "docked" {
-			undoCmd = "gt rig undock"
-		}
+		undoCmd := rigUnblockCmd(info.reason)
 		findings = append(findings, StagingFinding{
 			Severity:     "warning",
 			Category:     "blocked-rig",
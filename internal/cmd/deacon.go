@@ -15,11 +15,13 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/convoy"
 	"github.com/steveyegge/gastown/internal/deacon"
 	"github.com/steveyegge/gastown/internal/runtime"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/tui/feed"
 	"github.com/steveyegge/gastown/internal/util"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -359,6 +361,35 @@ This helps the Deacon understand which convoys have been recently fed.`,
 	RunE: runDeaconFeedStrandedState,
 }
 
+var deaconNudgeStuckCmd = &cobra.Command{
+	Use:   "nudge-stuck",
+	Short: "Nudge workers of stuck convoys, escalating if they stay stuck",
+	Long: `Nudge the workers of stuck convoys once, then escalate if still stuck.
+
+A convoy is "stuck" when it has workers but no tracked-issue activity for
+too long (the same work state shown by gt feed and gt convoy show).
+
+For each stuck episode (a convoy going from active, waiting, or idle to
+stuck):
+1. The first time the convoy is seen stuck, every worker is nudged once
+2. Later runs while it stays stuck do not nudge again
+3. If it is still stuck once the nudge window has passed, it is escalated
+   (once per episode) via gt escalate
+4. When the convoy recovers or closes, the episode ends
+
+The nudge window defaults to operational.deacon.stuck_convoy_nudge_window
+in settings/config.json (15m if unset). Episodes are tracked in
+deacon/stuck-convoy-state.json.
+
+This is called by the Deacon during patrol. Run manually for debugging.
+
+Examples:
+  gt deacon nudge-stuck               # Nudge or escalate stuck convoys
+  gt deacon nudge-stuck --window 30m  # Wait 30 minutes before escalating
+  gt deacon nudge-stuck --json        # Machine-readable output`,
+	RunE: runDeaconNudgeStuck,
+}
+
 var (
 	// Status flags
 	deaconStatusJSON bool
@@ -391,6 +422,10 @@ var (
 	feedStrandedMaxFeeds int
 	feedStrandedCooldown time.Duration
 	feedStrandedJSON     bool
+
+	// Nudge-stuck flags
+	nudgeStuckWindow time.Duration
+	nudgeStuckJSON   bool
 )
 
 func init() {
@@ -412,6 +447,7 @@ func init() {
 	deaconCmd.AddCommand(deaconRedispatchStateCmd)
 	deaconCmd.AddCommand(deaconFeedStrandedCmd)
 	deaconCmd.AddCommand(deaconFeedStrandedStateCmd)
+	deaconCmd.AddCommand(deaconNudgeStuckCmd)
 
 	// Flags for status
 	deaconStatusCmd.Flags().BoolVar(&deaconStatusJSON, "json", false, "Output as JSON")
@@ -460,6 +496,12 @@ func init() {
 	deaconFeedStrandedCmd.Flags().BoolVar(&feedStrandedJSON, "json", false,
 		"Output results as JSON")
 
+	// Flags for nudge-stuck
+	deaconNudgeStuckCmd.Flags().DurationVar(&nudgeStuckWindow, "window", 0,
+		"How long a convoy may stay stuck after the nudge before escalating (default: 15m)")
+	deaconNudgeStuckCmd.Flags().BoolVar(&nudgeStuckJSON, "json", false,
+		"Output results as JSON")
+
 	deaconStartCmd.Flags().StringVar(&deaconAgentOverride, "agent", "", "Agent alias to run the Deacon with (overrides town default)")
 	deaconAttachCmd.Flags().StringVar(&deaconAgentOverride, "agent", "", "Agent alias to run the Deacon with (overrides town default)")
 	deaconRestartCmd.Flags().StringVar(&deaconAgentOverride, "agent", "", "Agent alias to run the Deacon with (overrides town default)")
@@ -1633,3 +1675,82 @@ func runDeaconFeedStrandedState(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// runDeaconNudgeStuck nudges workers of newly stuck convoys and escalates
+// convoys that stay stuck past the nudge window.
+func runDeaconNudgeStuck(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	window := nudgeStuckWindow
+	if window <= 0 {
+		window = config.LoadOperationalConfig(townRoot).GetDeaconConfig().StuckConvoyNudgeWindowD()
+	}
+
+	convoys, err := feed.FetchConvoys(townRoot, 0)
+	if err != nil {
+		return fmt.Errorf("fetching convoys: %w", err)
+	}
+	if convoys.ToolingError != "" {
+		return fmt.Errorf("fetching convoys: %s", convoys.ToolingError)
+	}
+	if convoys.ListError != "" {
+		// Without the open list every episode would look closed.
+		return fmt.Errorf("fetching convoys: %s", convoys.ListError)
+	}
+
+	result, err := deacon.NudgeStuckConvoys(townRoot, stuckConvoyObservations(convoys.InProgress), window)
+	if err != nil {
+		return err
+	}
+
+	// JSON output
+	if nudgeStuckJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+
+	// Human-readable output
+	if len(result.Details) == 0 {
+		fmt.Printf("%s No stuck convoys\n", style.Dim.Render("○"))
+		return nil
+	}
+
+	for _, d := range result.Details {
+		switch d.Action {
+		case "nudged", "escalated":
+			fmt.Printf("  %s %s: %s\n", style.Bold.Render("✓"), d.ConvoyID, d.Message)
+		case "waiting", "recovered":
+			fmt.Printf("  %s %s: %s\n", style.Dim.Render("○"), d.ConvoyID, d.Message)
+		case "error":
+			fmt.Printf("  %s %s: %s\n", style.Dim.Render("✗"), d.ConvoyID, d.Message)
+		}
+	}
+
+	// Summary
+	fmt.Printf("\n%s Nudged: %d, Escalated: %d, Waiting: %d, Recovered: %d, Errors: %d\n",
+		style.Bold.Render("●"), result.Nudged, result.Escalated, result.Waiting, result.Recovered, result.Errors)
+
+	return nil
+}
+
+// stuckConvoyObservations converts open convoys into the deacon's view
+// used to track stuck episodes. A convoy whose tracked issues could not be
+// read is reported as Unknown rather than as not stuck.
+func stuckConvoyObservations(convoys []feed.Convoy) []deacon.ConvoyObservation {
+	obs := make([]deacon.ConvoyObservation, 0, len(convoys))
+	for _, c := range convoys {
+		obs = append(obs, deacon.ConvoyObservation{
+			ID:      c.ID,
+			Title:   c.Title,
+			Stuck:   c.WorkState == convoy.WorkStateStuck,
+			Reason:  c.StateReason,
+			Workers: c.Workers,
+			Unknown: c.TrackedError != "",
+		})
+	}
+	return obs
+}
@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/tui/feed"
+)
+
+func TestStuckConvoyObservations(t *testing.T) {
+	obs := stuckConvoyObservations([]feed.Convoy{
+		{ID: "hq-cv-a", Title: "Stuck", WorkState: convoy.WorkStateStuck, StateReason: "no activity for 2h", Workers: []string{"gastown/polecats/nux"}},
+		{ID: "hq-cv-b", Title: "Busy", WorkState: convoy.WorkStateActive, Workers: []string{"gastown/polecats/max"}},
+		{ID: "hq-cv-c", Title: "Unread", TrackedError: "listing tracked issues of hq-cv-c: exit status 1"},
+	})
+	if len(obs) != 3 {
+		t.Fatalf("got %d observations, want 3", len(obs))
+	}
+	if !obs[0].Stuck || obs[0].Reason != "no activity for 2h" || len(obs[0].Workers) != 1 {
+		t.Errorf("stuck convoy observation = %+v", obs[0])
+	}
+	if obs[1].Stuck || obs[1].Unknown {
+		t.Errorf("active convoy observed as stuck or unknown: %+v", obs[1])
+	}
+	if !obs[2].Unknown {
+		t.Errorf("unreadable convoy not marked unknown: %+v", obs[2])
+	}
+}
@@ -12,11 +12,14 @@ import (
 
 var (
 	doctorFix             bool
+	doctorFixDryRun       bool
 	doctorVerbose         bool
 	doctorRig             string
 	doctorRestartSessions bool
 	doctorNoStart         bool
 	doctorSlow            string
+	doctorOnly            []string
+	doctorSkip            []string
 )
 
 var doctorCmd = &cobra.Command{
@@ -109,14 +112,22 @@ Patrol checks:
   - patrol-plugins-accessible Verify plugin directories
 
 Use --fix to attempt automatic fixes for issues that support it.
+Use --fix-dry-run to show what --fix would change without changing anything.
 Use --no-start with --fix to suppress starting the daemon and agents.
 Use --rig to check a specific rig instead of the entire workspace.
-Use --slow to highlight slow checks (default threshold: 1s, e.g. --slow=500ms).`,
+Use --slow to highlight slow checks (default threshold: 1s, e.g. --slow=500ms).
+Use --only or --skip to run a subset of checks by name (comma-separated).
+
+Examples:
+  gt doctor --only orphan-sessions
+  gt doctor --only orphan-sessions,orphan-processes --fix
+  gt doctor --skip dolt-server-reachable,stale-binary`,
 	RunE: runDoctor,
 }
 
 func init() {
 	doctorCmd.Flags().BoolVar(&doctorFix, "fix", false, "Attempt to automatically fix issues")
+	doctorCmd.Flags().BoolVar(&doctorFixDryRun, "fix-dry-run", false, "Show what --fix would change without applying it")
 	doctorCmd.Flags().BoolVarP(&doctorVerbose, "verbose", "v", false, "Show detailed output")
 	doctorCmd.Flags().StringVar(&doctorRig, "rig", "", "Check specific rig only")
 	doctorCmd.Flags().BoolVar(&doctorRestartSessions, "restart-sessions", false, "Restart patrol sessions when fixing stale settings (use with --fix)")
@@ -124,10 +135,16 @@ func init() {
 	doctorCmd.Flags().StringVar(&doctorSlow, "slow", "", "Highlight slow checks (optional threshold, default 1s)")
 	// Allow --slow without a value (uses default 1s)
 	doctorCmd.Flags().Lookup("slow").NoOptDefVal = "1s"
+	doctorCmd.Flags().StringSliceVar(&doctorOnly, "only", nil, "Run only these checks (comma-separated names)")
+	doctorCmd.Flags().StringSliceVar(&doctorSkip, "skip", nil, "Skip these checks (comma-separated names)")
 	rootCmd.AddCommand(doctorCmd)
 }
 
 func runDoctor(cmd *cobra.Command, args []string) error {
+	if doctorFix && doctorFixDryRun {
+		return fmt.Errorf("--fix and --fix-dry-run are mutually exclusive")
+	}
+
 	// Find town root
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
@@ -182,6 +199,7 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 	d.Register(doctor.NewRigConfigSyncCheck()) // Check all registered rigs have config.json
 	d.Register(doctor.NewStaleDoltPortCheck()) // Check for stale Dolt port files
 	d.Register(doctor.NewPrefixMismatchCheck())
+	d.Register(doctor.NewRoutesPrefixCheck())
 	d.Register(doctor.NewDatabasePrefixCheck())
 	d.Register(doctor.NewIdleTimeoutCheck()) // Verify dolt.idle-timeout: "0" for all rigs
 	d.Register(doctor.NewRoutesCheck())
@@ -190,6 +208,7 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 	d.Register(doctor.NewMalformedSessionNameCheck())
 	d.Register(doctor.NewOrphanSessionCheck())
 	d.Register(doctor.NewZombieSessionCheck())
+	d.Register(doctor.NewZombiePaneCheck())
 	d.Register(doctor.NewOrphanProcessCheck())
 	d.Register(doctor.NewWispGCCheck())
 	d.Register(doctor.NewCheckMisclassifiedWisps())
@@ -215,6 +234,7 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 	d.Register(doctor.NewPatrolPluginDriftCheck())
 	d.Register(doctor.NewAgentBeadsCheck())
 	d.Register(doctor.NewStaleAgentBeadsCheck())
+	d.Register(doctor.NewStaleActiveMRCheck())
 	d.Register(doctor.NewRigBeadsCheck())
 	d.Register(doctor.NewRoleBeadsCheck())
 
@@ -271,6 +291,14 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 		d.RegisterAll(doctor.RigChecks()...)
 	}
 
+	// Narrow to the requested checks (--only / --skip)
+	if err := d.Filter(doctorOnly, doctorSkip); err != nil {
+		if doctorRig == "" && namesRigCheck(append(append([]string(nil), doctorOnly...), doctorSkip...)) {
+			return fmt.Errorf("%w (rig checks require --rig)", err)
+		}
+		return err
+	}
+
 	// Parse slow threshold (0 = disabled)
 	var slowThreshold time.Duration
 	if doctorSlow != "" {
@@ -286,6 +314,8 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 	var report *doctor.Report
 	if doctorFix {
 		report = d.FixStreaming(ctx, os.Stdout, slowThreshold)
+	} else if doctorFixDryRun {
+		report = d.FixDryRunStreaming(ctx, os.Stdout, slowThreshold)
 	} else {
 		report = d.RunStreaming(ctx, os.Stdout, slowThreshold)
 	}
@@ -300,3 +330,16 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// namesRigCheck reports whether any of names is a rig-level check, which is
+// only registered when --rig is given.
+func namesRigCheck(names []string) bool {
+	for _, check := range doctor.RigChecks() {
+		for _, name := range names {
+			if check.Name() == name {
+				return true
+			}
+		}
+	}
+	return false
+}
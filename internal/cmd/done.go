@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -17,6 +20,7 @@ import (
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/protocol"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
@@ -49,8 +53,17 @@ Examples:
   gt done                              # Submit branch, notify COMPLETED, transition to IDLE
   gt done --pre-verified               # Submit with pre-verification fast-path
   gt done --issue gt-abc               # Explicit issue ID
+  gt done --create-issue "Fix typo"    # Ad hoc work: create the source issue
+  gt done --remote staging             # Push branch to the staging remote
+  gt done --squash-to 3                # Squash the last 3 commits, then submit
+  gt done --require-substantive        # Refuse whitespace-only or generated-only diffs
+  gt done --link-pr https://github.com/org/repo/pull/42  # Reference a PR opened by hand
   gt done --status ESCALATED           # Signal blocker, skip MR
-  gt done --status DEFERRED            # Pause work, skip MR`,
+  gt done --status ESCALATED --reopen-on-escalate  # Also reopen the issue for triage
+  gt done --status DEFERRED            # Pause work, skip MR
+  gt done --status DEFERRED --checkpoint "parser done, flags next"  # Leave a resume note
+  gt done --quiet                      # Only print errors (for automation)
+  gt done --for gastown/nux            # Finalize a dead polecat's work (Witness/human)`,
 	RunE:         runDone,
 	SilenceUsage: true, // Don't print usage on operational errors (confuses agents)
 }
@@ -62,6 +75,15 @@ var (
 	doneCleanupStatus string
 	doneResume        bool
 	donePreVerified   bool
+	doneRemote        string
+	doneSquashTo      int
+	doneCreateIssue   string
+	doneFor           string
+
+	doneRequireSubstantive bool
+	doneReopenOnEscalate   bool
+	doneCheckpointNote     string
+	doneLinkPR             string
 )
 
 // Valid exit types for gt done
@@ -78,6 +100,14 @@ func init() {
 	doneCmd.Flags().StringVar(&doneCleanupStatus, "cleanup-status", "", "Git cleanup status: clean, uncommitted, unpushed, stash, unknown (ZFC: agent-observed)")
 	doneCmd.Flags().BoolVar(&doneResume, "resume", false, "Resume from last checkpoint (auto-detected, for Witness recovery)")
 	doneCmd.Flags().BoolVar(&donePreVerified, "pre-verified", false, "Mark MR as pre-verified (polecat ran gates after rebasing onto target)")
+	doneCmd.Flags().StringVar(&doneRemote, "remote", "", "Remote to push the branch to (default: rig push_remote, then origin)")
+	doneCmd.Flags().StringVar(&doneFor, "for", "", "Finalize work for another polecat (<rig>/<polecat>), using its worktree and identity")
+	doneCmd.Flags().StringVar(&doneCreateIssue, "create-issue", "", "Create a source issue with this title when none can be determined (ad hoc work)")
+	doneCmd.Flags().IntVar(&doneSquashTo, "squash-to", 0, "Squash the last N commits into one before pushing (refuses if any are already pushed)")
+	doneCmd.Flags().BoolVar(&doneRequireSubstantive, "require-substantive", false, "Refuse to submit a diff with only whitespace or generated-file changes")
+	doneCmd.Flags().BoolVar(&doneReopenOnEscalate, "reopen-on-escalate", false, "With --status ESCALATED: reopen a closed source issue and label it needs-human")
+	doneCmd.Flags().StringVar(&doneCheckpointNote, "checkpoint", "", "With --status DEFERRED: record where the work stands on the source issue and agent bead")
+	doneCmd.Flags().StringVar(&doneLinkPR, "link-pr", "", "Record an externally-created pull request URL on the MR bead as pr_url")
 
 	rootCmd.AddCommand(doneCmd)
 }
@@ -88,8 +118,10 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 	// Crew, deacons, witnesses etc. don't use gt done - they persist across tasks.
 	// Polecat sessions end with gt done — the session is cleaned up, but the
 	// polecat's persistent identity (agent bead, CV chain) survives across assignments.
+	// With --for, the caller (Witness or a human) finalizes another polecat's
+	// work, so the caller's own role does not matter.
 	actor := os.Getenv("BD_ACTOR")
-	if actor != "" && !isPolecatActor(actor) {
+	if doneFor == "" && actor != "" && !isPolecatActor(actor) {
 		return fmt.Errorf("gt done is for polecats only (you are %s)\nPolecat sessions end with gt done — the session is cleaned up, but identity persists.\nOther roles persist across tasks and don't use gt done.", actor)
 	}
 
@@ -98,6 +130,23 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 	if exitType != ExitCompleted && exitType != ExitEscalated && exitType != ExitDeferred {
 		return fmt.Errorf("invalid exit status '%s': must be COMPLETED, ESCALATED, or DEFERRED", doneStatus)
 	}
+	if doneCheckpointNote != "" && exitType != ExitDeferred {
+		return fmt.Errorf("--checkpoint requires --status DEFERRED")
+	}
+	if doneReopenOnEscalate && exitType != ExitEscalated {
+		return fmt.Errorf("--reopen-on-escalate requires --status ESCALATED")
+	}
+	if doneLinkPR != "" {
+		if exitType != ExitCompleted {
+			return fmt.Errorf("--link-pr requires --status COMPLETED")
+		}
+		if err := validatePRURL(doneLinkPR); err != nil {
+			return err
+		}
+	}
+	if doneIssue != "" && doneCreateIssue != "" {
+		return fmt.Errorf("--issue and --create-issue are mutually exclusive")
+	}
 
 	// Persistent polecat model (gt-hdf8): sessions stay alive after gt done.
 	// No deferred session kill — the polecat transitions to IDLE with sandbox
@@ -111,6 +160,18 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		return fmt.Errorf("not in a Gas Town workspace: %w", err)
 	}
 
+	// --for: operate on the named polecat's worktree instead of the caller's
+	// cwd, and ignore the caller's GT_* session environment below.
+	var forTarget *doneTarget
+	if doneFor != "" {
+		forTarget, err = resolveDoneTarget(townRoot, doneFor)
+		if err != nil {
+			return err
+		}
+		cwd = forTarget.Worktree
+		style.Printf("%s Finalizing work for %s/%s\n", style.Bold.Render("→"), forTarget.Rig, forTarget.Polecat)
+	}
+
 	// Track if cwd is available - affects which operations we can do
 	cwdAvailable := cwd != ""
 	if !cwdAvailable {
@@ -137,7 +198,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 	// When Claude Code resets shell cwd (e.g., to mayor/rig), the cwd-derived
 	// rig name is wrong (e.g., "mayor" instead of "vets"). GT_RIG is set
 	// reliably for polecats via session env injection.
-	if envRig := os.Getenv("GT_RIG"); envRig != "" {
+	if envRig := os.Getenv("GT_RIG"); envRig != "" && forTarget == nil {
 		rigName = envRig
 	}
 	if rigName == "" {
@@ -172,23 +233,12 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		}
 	}
 
-	// Normalize polecat CWD: polecats may run gt done from a subdirectory (e.g.,
-	// beads-ide/ inside the repo). beads.ResolveBeadsDir only looks at cwd/.beads,
-	// not parent dirs, so we must normalize to the git repo root before use.
-	// Walk up from cwd until we find .git, stopping if we leave the polecats area.
-	if cwdAvailable && cwdIsPolecatWorktree {
-		candidate := cwd
-		for {
-			if _, statErr := os.Stat(filepath.Join(candidate, ".git")); statErr == nil {
-				cwd = candidate
-				break
-			}
-			parent := filepath.Dir(candidate)
-			if parent == candidate || !strings.Contains(parent, "/polecats/") {
-				break // hit filesystem root or left polecats area
-			}
-			candidate = parent
-		}
+	// Normalize CWD to the worktree root: agents may run gt done from a
+	// subdirectory (e.g., beads-ide/ inside the repo). beads.ResolveBeadsDir
+	// only looks at cwd/.beads, not parent dirs, and several git operations
+	// assume the repo root, so resolve the git toplevel before use.
+	if cwdAvailable {
+		cwd = resolveWorktreeRoot(cwd, filepath.Join(townRoot, rigName))
 	}
 
 	// Initialize git - use cwd if available, otherwise use rig's mayor clone
@@ -221,7 +271,11 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		branch, err = g.CurrentBranch()
 		if err != nil {
 			// Last resort: try to extract from polecat name (polecat/<name>-<suffix>)
-			if polecatName := os.Getenv("GT_POLECAT"); polecatName != "" {
+			polecatName := os.Getenv("GT_POLECAT")
+			if forTarget != nil {
+				polecatName = forTarget.Polecat
+			}
+			if polecatName != "" {
 				branch = fmt.Sprintf("polecat/%s", polecatName)
 				style.PrintWarning("could not get branch from git, using fallback: %s", branch)
 			} else {
@@ -230,6 +284,14 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		}
 	}
 
+	// Get the default branch and push remote for this rig
+	defaultBranch, _ := rig.DefaultBranch(filepath.Join(townRoot, rigName))
+	rigCfg, rigCfgErr := rig.LoadRigConfig(filepath.Join(townRoot, rigName))
+	if rigCfgErr != nil {
+		rigCfg = nil
+	}
+	pushRemote := resolvePushRemote(doneRemote, rigCfg)
+
 	// Auto-detect cleanup status if not explicitly provided
 	// This prevents premature polecat cleanup by ensuring witness knows git state
 	if doneCleanupStatus == "" {
@@ -250,8 +312,8 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 				default:
 					// CheckUncommittedWork.UnpushedCommits doesn't work for branches
 					// without upstream tracking (common for polecats). Use the more
-					// robust BranchPushedToRemote which compares against origin/main.
-					pushed, unpushedCount, err := g.BranchPushedToRemote(branch, "origin")
+					// robust BranchPushedToRemote which compares against the push remote.
+					pushed, unpushedCount, err := g.BranchPushedToRemote(branch, pushRemote)
 					if err != nil {
 						style.PrintWarning("could not check if branch is pushed: %v", err)
 						doneCleanupStatus = "unpushed" // err on side of caution
@@ -277,6 +339,9 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 
 	// Determine polecat name from sender detection
 	sender := detectSender()
+	if forTarget != nil {
+		sender = forTarget.Address()
+	}
 	polecatName := ""
 	if parts := strings.Split(sender, "/"); len(parts) >= 2 {
 		polecatName = parts[len(parts)-1]
@@ -284,7 +349,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 
 	// Get agent bead ID for cross-referencing
 	var agentBeadID string
-	if roleInfo, err := GetRoleWithContext(cwd, townRoot); err == nil {
+	if roleInfo, err := doneRoleInfo(cwd, townRoot, forTarget); err == nil {
 		ctx := RoleContext{
 			Role:     roleInfo.Role,
 			Rig:      roleInfo.Rig,
@@ -308,6 +373,21 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		}
 	}
 
+	// Ad hoc work (--create-issue): with no source issue from the branch or
+	// hook, create one so the MR has something to link to via source_issue.
+	if doneCreateIssue != "" {
+		if issueID != "" {
+			style.PrintWarning("source issue %s already known; ignoring --create-issue", issueID)
+		} else {
+			createdID, err := createAdHocSourceIssue(beads.New(cwd), doneCreateIssue, sender, branch)
+			if err != nil {
+				return fmt.Errorf("creating source issue: %w", err)
+			}
+			issueID = createdID
+			style.Printf("%s Created source issue %s\n", style.Bold.Render("✓"), issueID)
+		}
+	}
+
 	// Write done-intent label EARLY, before push/MR operations.
 	// If gt done crashes after this point, the Witness can detect the intent
 	// and auto-nuke the zombie polecat.
@@ -322,7 +402,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		setDoneIntentLabel(bd, agentBeadID, exitType)
 		checkpoints = readDoneCheckpoints(bd, agentBeadID)
 		if len(checkpoints) > 0 {
-			fmt.Printf("%s Resuming gt done from checkpoint (previous run was interrupted)\n", style.Bold.Render("→"))
+			style.Printf("%s Resuming gt done from checkpoint (previous run was interrupted)\n", style.Bold.Render("→"))
 		}
 	}
 
@@ -330,14 +410,30 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 	// Tells the witness we're in the gt done flow — trust the agent until
 	// heartbeat goes stale. No timer-based inference needed.
 	// Parallel to done-intent label for backwards compat during migration.
-	if sessionName := os.Getenv("GT_SESSION"); sessionName != "" && townRoot != "" {
-		polecat.TouchSessionHeartbeatWithState(townRoot, sessionName, polecat.HeartbeatExiting, "gt done", issueID)
+	doneSession := os.Getenv("GT_SESSION")
+	if forTarget != nil {
+		doneSession = forTarget.SessionName()
+	}
+	if doneSession != "" && townRoot != "" {
+		polecat.TouchSessionHeartbeatWithState(townRoot, doneSession, polecat.HeartbeatExiting, "gt done", issueID)
 	}
 
-	// Get configured default branch for this rig
-	defaultBranch := "main" // fallback
-	if rigCfg, err := rig.LoadRigConfig(filepath.Join(townRoot, rigName)); err == nil && rigCfg.DefaultBranch != "" {
-		defaultBranch = rigCfg.DefaultBranch
+	// Accumulate this session's working time on the source issue. Skipped
+	// on resume so an interrupted gt done is not counted twice.
+	if issueID != "" && len(checkpoints) == 0 {
+		recordDoneTimeSpent(beads.New(cwd), issueID, doneSession)
+	}
+
+	// Warn (don't fail) when the branch doesn't follow the rig's naming
+	// convention: parseBranchName may have lost the issue ID.
+	if branch != "" && branch != defaultBranch {
+		template := polecatBranchTemplate(townRoot, rigName, rigCfg)
+		if !branchMatchesTemplate(branch, template) {
+			style.PrintWarning("branch '%s' does not match rig naming convention (expected %s)", branch, expectedBranchFormat(template))
+			if issueID == "" {
+				style.Printf("  Could not determine issue from branch; use --issue to specify\n")
+			}
+		}
 	}
 
 	// For COMPLETED, we need an issue ID and branch must not be the default branch
@@ -412,7 +508,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		// IMPORTANT: The error message must NOT mention --cleanup-status=clean.
 		// LLM agents read error messages and self-bypass (the original bug).
 		if aheadCount == 0 {
-			if os.Getenv("GT_POLECAT") != "" && doneCleanupStatus != "clean" && !isNoMergeTask {
+			if (os.Getenv("GT_POLECAT") != "" || forTarget != nil) && doneCleanupStatus != "clean" && !isNoMergeTask {
 				return fmt.Errorf("cannot complete: no commits on branch ahead of %s\n"+
 					"Polecats must have at least 1 commit to submit.\n"+
 					"If the bug was already fixed upstream: gt done --status DEFERRED\n"+
@@ -424,9 +520,9 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 			// (report-only tasks like audits/reviews), or no_merge polecat
 			// (non-code tasks like email/research per GH#2496):
 			// zero commits is valid.
-			fmt.Printf("%s Branch has no commits ahead of %s\n", style.Bold.Render("→"), originDefault)
-			fmt.Printf("  Work was likely pushed directly to main or already merged.\n")
-			fmt.Printf("  Skipping MR creation - completing without merge request.\n\n")
+			style.Printf("%s Branch has no commits ahead of %s\n", style.Bold.Render("→"), originDefault)
+			style.Printf("  Work was likely pushed directly to main or already merged.\n")
+			style.Printf("  Skipping MR creation - completing without merge request.\n\n")
 
 			// G15 fix: Close the base issue when completing with no MR.
 			// Without this, no-op polecats (bug already fixed) leave issues stuck
@@ -443,7 +539,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 				if issue, err := bd.Show(issueID); err == nil {
 					if unchecked := beads.HasUncheckedCriteria(issue); unchecked > 0 {
 						style.PrintWarning("issue %s has %d unchecked acceptance criteria — skipping close", issueID, unchecked)
-						fmt.Printf("  The bead will remain open for witness/mayor review.\n")
+						style.Printf("  The bead will remain open for witness/mayor review.\n")
 						skipClose = true
 					}
 				}
@@ -457,7 +553,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 					for attempt := 1; attempt <= 3; attempt++ {
 						closeErr = bd.ForceCloseWithReason(closeReason, issueID)
 						if closeErr == nil {
-							fmt.Printf("%s Issue %s closed (no MR needed)\n", style.Bold.Render("✓"), issueID)
+							style.Printf("%s Issue %s closed (no MR needed)\n", style.Bold.Render("✓"), issueID)
 							break
 						}
 						if attempt < 3 {
@@ -475,6 +571,14 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 			goto notifyWitness
 		}
 
+		// Substantive-diff gate: an MR whose diff is only whitespace or
+		// regenerated files wastes a Refinery cycle.
+		if doneRequireSubstantive {
+			if err := requireSubstantiveDiff(g, originDefault, defaultBranch, branch); err != nil {
+				return err
+			}
+		}
+
 		// Branch contamination preflight: check if branch is significantly behind
 		// origin/main, which indicates the branch may contain stale merge-base
 		// artifacts that will pollute the PR diff. (GH#2220)
@@ -492,6 +596,15 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 			}
 		}
 
+		// Optional squash before push. Skipped on resume once the branch is
+		// pushed: rewriting pushed commits would diverge from the remote.
+		if doneSquashTo > 0 && checkpoints[CheckpointPushed] == "" {
+			if err := squashBranchCommits(g, doneSquashTo, aheadCount, issueID); err != nil {
+				return err
+			}
+			style.Printf("%s Squashed last %d commits into one\n", style.Bold.Render("✓"), doneSquashTo)
+		}
+
 		// Determine merge strategy from convoy (gt-myofa.3)
 		// Convoys can override the default MR-based workflow:
 		//   direct: push commits straight to target branch, bypass refinery
@@ -510,19 +623,19 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 
 		// Handle "local" strategy: skip push and MR entirely
 		if convoyInfo != nil && convoyInfo.MergeStrategy == "local" {
-			fmt.Printf("%s Local merge strategy: skipping push and merge queue\n", style.Bold.Render("→"))
-			fmt.Printf("  Branch: %s\n", branch)
+			style.Printf("%s Local merge strategy: skipping push and merge queue\n", style.Bold.Render("→"))
+			style.Printf("  Branch: %s\n", branch)
 			if issueID != "" {
-				fmt.Printf("  Issue: %s\n", issueID)
+				style.Printf("  Issue: %s\n", issueID)
 			}
-			fmt.Println()
-			fmt.Printf("%s\n", style.Dim.Render("Work stays on local feature branch."))
+			style.Println()
+			style.Printf("%s\n", style.Dim.Render("Work stays on local feature branch."))
 			goto notifyWitness
 		}
 
 		// Handle "direct" strategy: push to target branch, skip MR
 		if convoyInfo != nil && convoyInfo.MergeStrategy == "direct" {
-			fmt.Printf("%s Direct merge strategy: pushing to %s\n", style.Bold.Render("→"), defaultBranch)
+			style.Printf("%s Direct merge strategy: pushing to %s\n", style.Bold.Render("→"), defaultBranch)
 			directRefspec := branch + ":" + defaultBranch
 			directPushErr := g.Push("origin", directRefspec, false)
 			if directPushErr != nil {
@@ -532,7 +645,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 				style.PrintWarning("%s", errMsg)
 				goto notifyWitness
 			}
-			fmt.Printf("%s Branch pushed directly to %s\n", style.Bold.Render("✓"), defaultBranch)
+			style.Printf("%s Branch pushed directly to %s\n", style.Bold.Render("✓"), defaultBranch)
 
 			// Close the base issue — no MR/refinery will close it
 			if issueID != "" {
@@ -542,7 +655,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 				for attempt := 1; attempt <= 3; attempt++ {
 					closeErr = directBd.ForceCloseWithReason(closeReason, issueID)
 					if closeErr == nil {
-						fmt.Printf("%s Issue %s closed (direct merge)\n", style.Bold.Render("✓"), issueID)
+						style.Printf("%s Issue %s closed (direct merge)\n", style.Bold.Render("✓"), issueID)
 						break
 					}
 					if attempt < 3 {
@@ -566,7 +679,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 
 		// Resume: skip push if already completed in a previous run (gt-aufru)
 		if checkpoints[CheckpointPushed] != "" {
-			fmt.Printf("%s Branch already pushed (resumed from checkpoint)\n", style.Bold.Render("✓"))
+			style.Printf("%s Branch already pushed (resumed from checkpoint)\n", style.Bold.Render("✓"))
 			goto afterPush
 		}
 
@@ -579,9 +692,18 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		// Without refspec, git push follows the tracking config — polecat branches
 		// track origin/main, so a bare push sends commits to main directly,
 		// bypassing the MR/refinery flow (G20 root cause).
-		fmt.Printf("Pushing branch to remote...\n")
+		if pushRemote != "origin" {
+			if _, err := g.RemoteURL(pushRemote); err != nil {
+				pushFailed = true
+				errMsg := fmt.Sprintf("push remote '%s' is not configured: %v", pushRemote, err)
+				doneErrors = append(doneErrors, errMsg)
+				style.PrintWarning("%s\nCommits exist locally but were not pushed. Witness will be notified.", errMsg)
+				goto notifyWitness
+			}
+		}
+		style.Printf("Pushing branch to %s...\n", pushRemote)
 		refspec = branch + ":" + branch
-		pushErr = g.Push("origin", refspec, false)
+		pushErr = g.Push(pushRemote, refspec, false)
 		if pushErr != nil {
 			// Primary push failed — try fallback from the bare repo (GH #1348).
 			// When polecat sessions are reused or worktrees are stale, the worktree's
@@ -592,22 +714,22 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 			bareRepoPath := filepath.Join(rigPath, ".repo.git")
 			if _, statErr := os.Stat(bareRepoPath); statErr == nil {
 				bareGit := git.NewGitWithDir(bareRepoPath, "")
-				pushErr = bareGit.Push("origin", refspec, false)
+				pushErr = bareGit.Push(pushRemote, refspec, false)
 				if pushErr != nil {
 					style.PrintWarning("bare repo push also failed: %v", pushErr)
 				} else {
-					fmt.Printf("%s Branch pushed via bare repo fallback\n", style.Bold.Render("✓"))
+					style.Printf("%s Branch pushed via bare repo fallback\n", style.Bold.Render("✓"))
 				}
 			} else {
 				// No bare repo — try mayor/rig as last resort
 				mayorPath := filepath.Join(rigPath, "mayor", "rig")
 				if _, statErr := os.Stat(mayorPath); statErr == nil {
 					mayorGit := git.NewGit(mayorPath)
-					pushErr = mayorGit.Push("origin", refspec, false)
+					pushErr = mayorGit.Push(pushRemote, refspec, false)
 					if pushErr != nil {
 						style.PrintWarning("mayor/rig push also failed: %v", pushErr)
 					} else {
-						fmt.Printf("%s Branch pushed via mayor/rig fallback\n", style.Bold.Render("✓"))
+						style.Printf("%s Branch pushed via mayor/rig fallback\n", style.Bold.Render("✓"))
 					}
 				}
 			}
@@ -625,7 +747,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		// Verify the branch actually exists on remote (GH #1348).
 		// Push can return exit 0 without actually pushing (e.g., stale refs,
 		// worktree/bare-repo state mismatch). Verify before creating MR bead.
-		if exists, verifyErr := g.RemoteBranchExists("origin", branch); verifyErr != nil {
+		if exists, verifyErr := g.RemoteBranchExists(pushRemote, branch); verifyErr != nil {
 			style.PrintWarning("could not verify push: %v (proceeding optimistically)", verifyErr)
 		} else if !exists {
 			// Push "succeeded" but branch not on remote — try bare repo verification
@@ -634,7 +756,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 			bareRepoPath := filepath.Join(rigPath, ".repo.git")
 			if _, statErr := os.Stat(bareRepoPath); statErr == nil {
 				bareGit := git.NewGitWithDir(bareRepoPath, "")
-				exists, verifyErr = bareGit.RemoteBranchExists("origin", branch)
+				exists, verifyErr = bareGit.RemoteBranchExists(pushRemote, branch)
 			}
 			if verifyErr != nil || !exists {
 				pushFailed = true
@@ -644,7 +766,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 				goto notifyWitness
 			}
 		}
-		fmt.Printf("%s Branch pushed to origin\n", style.Bold.Render("✓"))
+		style.Printf("%s Branch pushed to %s\n", style.Bold.Render("✓"), pushRemote)
 
 		// Fix cleanup_status after successful push (gt-wcr).
 		// Status was detected before push, so "unpushed" is now stale.
@@ -674,30 +796,30 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		bd := beads.NewWithBeadsDir(cwd, resolvedBeads)
 
 		// Check for no_merge flag - if set, skip merge queue and notify for review
-		sourceIssueForNoMerge, err := bd.Show(issueID)
+		sourceIssueForNoMerge, err := withBeadsLockRetry(func() (*beads.Issue, error) { return bd.Show(issueID) })
 		if err == nil {
 			attachmentFields := beads.ParseAttachmentFields(sourceIssueForNoMerge)
 			if attachmentFields != nil && attachmentFields.NoMerge {
-				fmt.Printf("%s No-merge mode: skipping merge queue\n", style.Bold.Render("→"))
-				fmt.Printf("  Branch: %s\n", branch)
-				fmt.Printf("  Issue: %s\n", issueID)
-				fmt.Println()
-				fmt.Printf("%s\n", style.Dim.Render("Work stays on feature branch for human review."))
+				style.Printf("%s No-merge mode: skipping merge queue\n", style.Bold.Render("→"))
+				style.Printf("  Branch: %s\n", branch)
+				style.Printf("  Issue: %s\n", issueID)
+				style.Println()
+				style.Printf("%s\n", style.Dim.Render("Work stays on feature branch for human review."))
 
 				// Mail dispatcher with READY_FOR_REVIEW
-				if dispatcher := attachmentFields.DispatchedBy; dispatcher != "" {
+				if dispatcher := attachmentFields.DispatchedBy; shouldNotifyDispatcher(dispatcher, sender) {
 					townRouter := mail.NewRouter(townRoot)
 					defer townRouter.WaitPendingNotifications()
 					reviewMsg := &mail.Message{
 						To:      dispatcher,
-						From:    detectSender(),
+						From:    sender,
 						Subject: fmt.Sprintf("READY_FOR_REVIEW: %s", issueID),
-						Body:    fmt.Sprintf("Branch: %s\nIssue: %s\nReady for review.", branch, issueID),
+						Body:    dispatcherReviewBody(exitType, issueID, branch, doneLinkPR),
 					}
 					if err := townRouter.Send(reviewMsg); err != nil {
 						style.PrintWarning("could not notify dispatcher: %v", err)
 					} else {
-						fmt.Printf("%s Dispatcher notified: READY_FOR_REVIEW\n", style.Bold.Render("✓"))
+						style.Printf("%s Dispatcher notified: READY_FOR_REVIEW\n", style.Bold.Render("✓"))
 					}
 				}
 
@@ -716,8 +838,8 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 			convoyInfo = getConvoyInfoForIssue(issueID)
 		}
 		if convoyInfo != nil && convoyInfo.MergeStrategy == "direct" {
-			fmt.Printf("%s Late-detected direct merge strategy: pushing to %s\n", style.Bold.Render("→"), defaultBranch)
-			fmt.Printf("  Convoy: %s\n", convoyInfo.ID)
+			style.Printf("%s Late-detected direct merge strategy: pushing to %s\n", style.Bold.Render("→"), defaultBranch)
+			style.Printf("  Convoy: %s\n", convoyInfo.ID)
 
 			// Push branch directly to main (the earlier push went to origin/<branch>)
 			directRefspec := branch + ":" + defaultBranch
@@ -726,7 +848,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 				// Direct push failed — fall through to normal MR creation
 				style.PrintWarning("late direct push to %s failed: %v — falling through to MR", defaultBranch, directPushErr)
 			} else {
-				fmt.Printf("%s Branch pushed directly to %s\n", style.Bold.Render("✓"), defaultBranch)
+				style.Printf("%s Branch pushed directly to %s\n", style.Bold.Render("✓"), defaultBranch)
 
 				// Close the issue directly — refinery won't process it.
 				if issueID != "" {
@@ -735,7 +857,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 						closeErr = bd.ForceCloseWithReason(
 							fmt.Sprintf("Direct merge to %s (convoy strategy, late detection)", defaultBranch), issueID)
 						if closeErr == nil {
-							fmt.Printf("%s Issue %s closed (direct merge)\n", style.Bold.Render("✓"), issueID)
+							style.Printf("%s Issue %s closed (direct merge)\n", style.Bold.Render("✓"), issueID)
 							break
 						}
 						if attempt < 3 {
@@ -752,6 +874,8 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 			}
 		}
 
+		profile := doneProfile(forTarget)
+
 		// Determine target branch for the MR.
 		// Priority: explicit --base-branch > integration branch auto-detect > rig default.
 		target := defaultBranch
@@ -764,7 +888,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 			if af := beads.ParseAttachmentFields(sourceIssueForNoMerge); af != nil {
 				if bb := extractFormulaVar(af.FormulaVars, "base_branch"); bb != "" && bb != defaultBranch {
 					target = bb
-					fmt.Printf("  Target branch override: %s (from --base-branch)\n", target)
+					style.Printf("  Target branch override: %s (from --base-branch)\n", target)
 				}
 			}
 		}
@@ -806,12 +930,12 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		// re-attempts bd.Create which hits unique constraints or creates duplicates.
 		if checkpoints[CheckpointMRCreated] != "" {
 			mrID = checkpoints[CheckpointMRCreated]
-			fmt.Printf("%s MR already created (resumed from checkpoint: %s)\n", style.Bold.Render("✓"), mrID)
+			style.Printf("%s MR already created (resumed from checkpoint: %s)\n", style.Bold.Render("✓"), mrID)
 			goto afterMR
 		}
 
 		// Check if MR bead already exists for this branch (idempotency)
-		existingMR, err = bd.FindMRForBranch(branch)
+		existingMR, err = withBeadsLockRetry(func() (*beads.Issue, error) { return bd.FindMRForBranch(branch) })
 		if err != nil {
 			style.PrintWarning("could not check for existing MR: %v", err)
 			// Continue with creation attempt - Create will fail if duplicate
@@ -820,11 +944,30 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		if existingMR != nil {
 			// MR already exists - use it instead of creating a new one
 			mrID = existingMR.ID
-			fmt.Printf("%s MR already exists (idempotent)\n", style.Bold.Render("✓"))
-			fmt.Printf("  MR ID: %s\n", style.Bold.Render(mrID))
+			style.Printf("%s MR already exists (idempotent)\n", style.Bold.Render("✓"))
+			style.Printf("  MR ID: %s\n", style.Bold.Render(mrID))
+			if doneLinkPR != "" {
+				if err := bd.SetMRPRURL(mrID, doneLinkPR); err != nil {
+					style.PrintWarning("could not link PR on existing MR %s: %v", mrID, err)
+				}
+			}
 		} else {
-			// Build MR bead title and description
-			title := fmt.Sprintf("Merge: %s", issueID)
+			// Build MR bead title and description. Rigs may customize the
+			// title and a trailing summary via merge_queue templates.
+			var titleTmpl, descTmpl string
+			if settings, err := config.LoadRigSettings(filepath.Join(townRoot, rigName, "settings", "config.json")); err == nil && settings.MergeQueue != nil {
+				titleTmpl = settings.MergeQueue.MRTitleTemplate
+				descTmpl = settings.MergeQueue.MRDescriptionTemplate
+			}
+			mrVars := mrTemplateVars{Issue: issueID, Branch: branch, Target: target, Worker: worker, Rig: rigName}
+			title, err := renderMRTitle(titleTmpl, mrVars)
+			if err != nil {
+				style.PrintWarning("invalid mr_title_template, using default: %v", err)
+			}
+			summary, err := renderMRDescription(descTmpl, mrVars)
+			if err != nil {
+				style.PrintWarning("invalid mr_description_template, using default: %v", err)
+			}
 			description := fmt.Sprintf("branch: %s\ntarget: %s\nsource_issue: %s\nrig: %s",
 				branch, target, issueID, rigName)
 			if worker != "" {
@@ -853,13 +996,30 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 				}
 			}
 
-			mrIssue, err := bd.Create(beads.CreateOptions{
-				Title:       title,
-				Labels:      []string{"gt:merge-request"},
-				Priority:    priority,
-				Description: description,
-				Ephemeral:   true,
+			// Attach a changed-files summary for reviewers and Refinery triage.
+			description = appendDiffStat(description, g, "origin/"+target, branch)
+			description = appendProfile(description, profile)
+			description = appendPRURL(description, doneLinkPR)
+			description = appendPushRemote(description, pushRemote)
+			description = appendMRSummary(description, summary)
+
+			mrIssue, err := withBeadsLockRetry(func() (*beads.Issue, error) {
+				return bd.Create(beads.CreateOptions{
+					Title:       title,
+					Labels:      []string{"gt:merge-request"},
+					Priority:    priority,
+					Description: description,
+					Ephemeral:   true,
+				})
 			})
+			if errors.Is(err, beads.ErrDuplicateBead) {
+				// A concurrent or earlier run created the MR between the
+				// idempotency check and Create; adopt it.
+				if dup, findErr := bd.FindMRForBranch(branch); findErr == nil && dup != nil {
+					mrIssue, err = dup, nil
+					style.Printf("%s MR already exists (idempotent)\n", style.Bold.Render("✓"))
+				}
+			}
 			if err != nil {
 				// Non-fatal: record the error and skip to notifyWitness.
 				// Push succeeded so branch is on remote, but MR bead failed.
@@ -886,7 +1046,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 			// bd.Create() succeeds when the bead is written locally, but if the write
 			// didn't persist (Dolt failure, corrupt state), we'd nuke the worktree
 			// with no MR in the queue — losing the polecat's work permanently.
-			if verifiedMR, verifyErr := bd.Show(mrID); verifyErr != nil || verifiedMR == nil {
+			if verifiedMR, verifyErr := withBeadsLockRetry(func() (*beads.Issue, error) { return bd.Show(mrID) }); verifyErr != nil || verifiedMR == nil {
 				mrFailed = true
 				errMsg := fmt.Sprintf("MR bead created but verification read-back failed (id=%s): %v", mrID, verifyErr)
 				doneErrors = append(doneErrors, errMsg)
@@ -904,14 +1064,14 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 			// GH#2599: Back-link source issue to MR bead for discoverability.
 			if issueID != "" {
 				comment := fmt.Sprintf("MR created: %s", mrID)
-				if _, err := bd.Run("comments", "add", issueID, comment); err != nil {
+				if err := addCommentWithRetry(bd, issueID, comment); err != nil {
 					style.PrintWarning("could not back-link source issue %s to MR %s: %v", issueID, mrID, err)
 				}
 			}
 
 			// Success output
-			fmt.Printf("%s Work submitted to merge queue (verified)\n", style.Bold.Render("✓"))
-			fmt.Printf("  MR ID: %s\n", style.Bold.Render(mrID))
+			style.Printf("%s Work submitted to merge queue (verified)\n", style.Bold.Render("✓"))
+			style.Printf("  MR ID: %s\n", style.Bold.Render(mrID))
 
 			// NOTE: Refinery nudge is deferred to AFTER the Dolt branch merge
 			// (see post-merge nudge below). Nudging here would race with the
@@ -926,22 +1086,39 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		}
 
 	afterMR:
-		fmt.Printf("  Source: %s\n", branch)
-		fmt.Printf("  Target: %s\n", target)
-		fmt.Printf("  Issue: %s\n", issueID)
+		style.Printf("  Source: %s\n", branch)
+		style.Printf("  Target: %s\n", target)
+		style.Printf("  Issue: %s\n", issueID)
 		if worker != "" {
-			fmt.Printf("  Worker: %s\n", worker)
+			style.Printf("  Worker: %s\n", worker)
 		}
-		fmt.Printf("  Priority: P%d\n", priority)
-		fmt.Println()
-		fmt.Printf("%s\n", style.Dim.Render("The Refinery will process your merge request."))
+		style.Printf("  Priority: P%d\n", priority)
+		style.Println()
+		style.Printf("%s\n", style.Dim.Render("The Refinery will process your merge request."))
 	} else {
 		// For ESCALATED or DEFERRED, just print status
-		fmt.Printf("%s Signaling %s\n", style.Bold.Render("→"), exitType)
+		style.Printf("%s Signaling %s\n", style.Bold.Render("→"), exitType)
 		if issueID != "" {
-			fmt.Printf("  Issue: %s\n", issueID)
+			style.Printf("  Issue: %s\n", issueID)
+		}
+		style.Printf("  Branch: %s\n", branch)
+
+		if exitType == ExitEscalated && doneReopenOnEscalate && issueID != "" {
+			reopened, err := reopenEscalatedIssue(beads.New(cwd), issueID)
+			switch {
+			case err != nil:
+				// Non-fatal: the escalation itself still goes through.
+				style.PrintWarning("could not flag %s for triage: %v", issueID, err)
+			case reopened:
+				style.Printf("%s Reopened %s and labeled %s\n", style.Bold.Render("✓"), issueID, needsHumanLabel)
+			default:
+				style.Printf("%s Labeled %s %s\n", style.Bold.Render("✓"), issueID, needsHumanLabel)
+			}
+		}
+
+		if exitType == ExitDeferred && doneCheckpointNote != "" {
+			recordResumeNote(beads.New(cwd), issueID, agentBeadID, sender, doneCheckpointNote)
 		}
-		fmt.Printf("  Branch: %s\n", branch)
 	}
 
 notifyWitness:
@@ -954,7 +1131,7 @@ notifyWitness:
 	// Self-managed completion (gt-1qlg): metadata is retained for anomaly
 	// detection and crash recovery by witness patrol, but the witness no
 	// longer processes routine completions from these fields.
-	fmt.Printf("\nNotifying Witness...\n")
+	style.Printf("\nNotifying Witness...\n")
 	if agentBeadID != "" {
 		completionBd := beads.New(cwd)
 		meta := &beads.CompletionMetadata{
@@ -974,8 +1151,8 @@ notifyWitness:
 	// Self-managed completion (gt-1qlg): witness no longer processes routine completions.
 	// The nudge is kept for observability — witness logs the event but doesn't
 	// need to act on it. Nudges are free (no Dolt commit).
-	nudgeWitness(rigName, fmt.Sprintf("POLECAT_DONE %s exit=%s", polecatName, exitType))
-	fmt.Printf("%s Witness notified of %s (via nudge)\n", style.Bold.Render("✓"), exitType)
+	nudgeWitness(rigName, witnessDoneNudge(polecatName, exitType, doneLinkPR))
+	style.Printf("%s Witness notified of %s (via nudge)\n", style.Bold.Render("✓"), exitType)
 
 	// Write witness notification checkpoint for resume (gt-aufru)
 	if agentBeadID != "" {
@@ -992,19 +1169,23 @@ notifyWitness:
 	}
 
 	// Update agent bead state (ZFC: self-report completion)
-	updateAgentStateOnDone(cwd, townRoot, exitType, issueID)
+	if forTarget != nil {
+		updateAgentStateForRole(forTarget.RoleInfo(townRoot), cwd, townRoot, exitType, issueID)
+	} else {
+		updateAgentStateOnDone(cwd, townRoot, exitType, issueID)
+	}
 
 	// Persistent polecat model (gt-hdf8): polecats transition to IDLE after completion.
 	// Session stays alive, sandbox preserved, worktree synced to main for reuse.
 	// "done means idle" - not "done means dead".
 	isPolecat := false
-	if roleInfo, err := GetRoleWithContext(cwd, townRoot); err == nil && roleInfo.Role == RolePolecat {
+	if roleInfo, err := doneRoleInfo(cwd, townRoot, forTarget); err == nil && roleInfo.Role == RolePolecat {
 		isPolecat = true
 
-		fmt.Printf("%s Sandbox preserved for reuse (persistent polecat)\n", style.Bold.Render("✓"))
+		style.Printf("%s Sandbox preserved for reuse (persistent polecat)\n", style.Bold.Render("✓"))
 
 		if pushFailed || mrFailed {
-			fmt.Printf("%s Work needs recovery (push or MR failed) — session preserved\n", style.Bold.Render("⚠"))
+			style.Printf("%s Work needs recovery (push or MR failed) — session preserved\n", style.Bold.Render("⚠"))
 		}
 
 		// Sync worktree to main so the polecat is ready for new assignments.
@@ -1015,13 +1196,13 @@ notifyWitness:
 			// Remember the old branch so we can delete it after switching
 			oldBranch := branch
 
-			fmt.Printf("%s Syncing worktree to %s...\n", style.Bold.Render("→"), defaultBranch)
+			style.Printf("%s Syncing worktree to %s...\n", style.Bold.Render("→"), defaultBranch)
 			if err := g.Checkout(defaultBranch); err != nil {
 				style.PrintWarning("could not checkout %s: %v (worktree stays on feature branch)", defaultBranch, err)
 			} else if err := g.Pull("origin", defaultBranch); err != nil {
 				style.PrintWarning("could not pull %s: %v (worktree on %s but may be stale)", defaultBranch, defaultBranch, err)
 			} else {
-				fmt.Printf("%s Worktree synced to %s\n", style.Bold.Render("✓"), defaultBranch)
+				style.Printf("%s Worktree synced to %s\n", style.Bold.Render("✓"), defaultBranch)
 			}
 
 			// Delete the old polecat branch (non-fatal: cleanup only).
@@ -1030,22 +1211,428 @@ notifyWitness:
 				if err := g.DeleteBranch(oldBranch, true); err != nil {
 					style.PrintWarning("could not delete old branch %s: %v", oldBranch, err)
 				} else {
-					fmt.Printf("%s Deleted old branch %s\n", style.Bold.Render("✓"), oldBranch)
+					style.Printf("%s Deleted old branch %s\n", style.Bold.Render("✓"), oldBranch)
 				}
 			}
 		}
 
-		fmt.Printf("%s Polecat transitioned to IDLE — ready for new work\n", style.Bold.Render("✓"))
+		style.Printf("%s Polecat transitioned to IDLE — ready for new work\n", style.Bold.Render("✓"))
 	}
 
-	fmt.Println()
+	style.Println()
 	if !isPolecat {
-		fmt.Printf("%s Session exiting\n", style.Bold.Render("→"))
-		fmt.Printf("  Witness will handle cleanup.\n")
+		style.Printf("%s Session exiting\n", style.Bold.Render("→"))
+		style.Printf("  Witness will handle cleanup.\n")
+	}
+	return nil
+}
+
+// Lock retry policy for beads operations in the gt done submission path.
+// Variables so tests can shorten the backoff.
+var (
+	doneLockRetryAttempts = 4
+	doneLockRetryBackoff  = 250 * time.Millisecond
+)
+
+// isBeadsLockError reports whether err is bd failing on a database lock held
+// by another process. Such failures are transient under contention. Errors
+// from the beads package carry beads.ErrDBLocked; errors that reach here
+// without passing through it are classified by their message the same way.
+func isBeadsLockError(err error) bool {
+	if err == nil {
+		return false
+	}
+	return errors.Is(err, beads.ErrDBLocked) || errors.Is(beads.ClassifyStderr(err.Error()), beads.ErrDBLocked)
+}
+
+// withBeadsLockRetry runs fn, retrying with linear backoff while it fails
+// with a database lock error, so a transient lock doesn't lose a submission
+// whose branch is already pushed. Other errors are returned immediately.
+func withBeadsLockRetry[T any](fn func() (T, error)) (T, error) {
+	var result T
+	var err error
+	for attempt := 1; attempt <= doneLockRetryAttempts; attempt++ {
+		result, err = fn()
+		if !isBeadsLockError(err) {
+			return result, err
+		}
+		if attempt < doneLockRetryAttempts {
+			time.Sleep(time.Duration(attempt) * doneLockRetryBackoff)
+		}
+	}
+	return result, err
+}
+
+// dispatcherReviewBody builds the READY_FOR_REVIEW mail body sent to the
+// dispatcher in no-merge mode. The key-value lines are the completion record
+// protocol.ParsePolecatDonePayload reads, followed by a human note.
+func dispatcherReviewBody(exitType, issueID, branch, prURL string) string {
+	return protocol.FormatPolecatDoneBody(protocol.PolecatDonePayload{
+		ExitType: exitType,
+		Issue:    issueID,
+		Branch:   branch,
+		PRURL:    prURL,
+	}) + "Ready for review."
+}
+
+// witnessDoneNudge is the POLECAT_DONE nudge sent to the Witness, with the
+// linked PR appended when there is one.
+func witnessDoneNudge(polecatName, exitType, prURL string) string {
+	msg := fmt.Sprintf("POLECAT_DONE %s exit=%s", polecatName, exitType)
+	if prURL != "" {
+		msg += " pr=" + prURL
+	}
+	return msg
+}
+
+// shouldNotifyDispatcher reports whether the dispatcher recorded on the source
+// issue should be mailed about completion. No mail is sent when no dispatcher
+// was recorded or when the agent finishing the work dispatched it itself.
+func shouldNotifyDispatcher(dispatcher, sender string) bool {
+	if dispatcher == "" {
+		return false
+	}
+	return strings.TrimSuffix(dispatcher, "/") != strings.TrimSuffix(sender, "/")
+}
+
+// squashBranchCommits squashes the last n commits on HEAD into a single
+// commit whose message references issueID. It refuses when n exceeds the
+// commits ahead of the target (aheadCount), or when any of the commits is
+// already on a remote-tracking branch.
+func squashBranchCommits(g *git.Git, n, aheadCount int, issueID string) error {
+	if n < 2 {
+		return fmt.Errorf("--squash-to must be at least 2 (got %d)", n)
+	}
+	if n > aheadCount {
+		return fmt.Errorf("cannot squash %d commits: branch has only %d commit(s) ahead of its target", n, aheadCount)
+	}
+
+	// The reset keeps the index, so any staged or modified tracked files
+	// would be folded into the squash commit.
+	dirty, err := g.HasTrackedChanges()
+	if err != nil {
+		return fmt.Errorf("checking working tree: %w", err)
+	}
+	if dirty {
+		return fmt.Errorf("cannot squash: uncommitted changes would be included in the squash commit\nCommit or stash them, then run gt done again")
+	}
+
+	// If any of the n commits is on a remote, so is the oldest of them.
+	oldest := fmt.Sprintf("HEAD~%d", n-1)
+	pushed, err := g.RemoteBranchesContaining(oldest)
+	if err != nil {
+		return fmt.Errorf("checking whether commits are pushed: %w", err)
+	}
+	if len(pushed) > 0 {
+		return fmt.Errorf("cannot squash: commits already pushed to %s", strings.Join(pushed, ", "))
+	}
+
+	log, err := g.RecentCommits(n)
+	if err != nil {
+		return fmt.Errorf("reading commits to squash: %w", err)
+	}
+	origHead, err := g.Rev("HEAD")
+	if err != nil {
+		return fmt.Errorf("resolving HEAD: %w", err)
+	}
+
+	if err := g.ResetSoft(fmt.Sprintf("HEAD~%d", n)); err != nil {
+		return fmt.Errorf("squashing commits: %w", err)
+	}
+	if err := g.Commit(squashCommitMessage(strings.Split(log, "\n"), issueID)); err != nil {
+		_ = g.ResetSoft(origHead)
+		return fmt.Errorf("committing squashed changes: %w", err)
 	}
 	return nil
 }
 
+// squashCommitMessage builds the message for a squashed commit from
+// newest-first "<hash> <subject>" lines: the oldest subject (tagged with the
+// issue ID) followed by the list of squashed commits.
+func squashCommitMessage(oneline []string, issueID string) string {
+	subject := oneline[len(oneline)-1]
+	if _, rest, ok := strings.Cut(subject, " "); ok {
+		subject = rest
+	}
+	if issueID != "" && !strings.Contains(subject, issueID) {
+		subject += " (" + issueID + ")"
+	}
+
+	var b strings.Builder
+	b.WriteString(subject)
+	fmt.Fprintf(&b, "\n\nSquashed %d commits:\n", len(oneline))
+	for i := len(oneline) - 1; i >= 0; i-- {
+		b.WriteString("- " + oneline[i] + "\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// appendDiffStat adds a "diffstat:" line summarizing branch's changes since
+// base to an MR description. The description is returned unchanged if the
+// stat cannot be computed.
+func appendDiffStat(description string, g *git.Git, base, branch string) string {
+	stat, err := g.DiffStat(base, branch)
+	if err != nil {
+		return description
+	}
+	return description + "\ndiffstat: " + stat.String()
+}
+
+// doneProfile returns the profile the agent is running under: GT_PROFILE if
+// set, otherwise the agent preset from GT_AGENT. Empty if neither is set, and
+// always empty under --for: the environment is the caller's, and the target
+// polecat's profile is not recorded anywhere gt done can read it.
+func doneProfile(target *doneTarget) string {
+	if target != nil {
+		return ""
+	}
+	if p := strings.TrimSpace(os.Getenv("GT_PROFILE")); p != "" {
+		return p
+	}
+	return strings.TrimSpace(os.Getenv("GT_AGENT"))
+}
+
+// appendProfile adds a "profile:" line to an MR description so outcomes can
+// be correlated with profiles. The description is returned unchanged if no
+// profile is set.
+func appendProfile(description, profile string) string {
+	if profile != "" {
+		return description + "\nprofile: " + profile
+	}
+	return description
+}
+
+// appendPRURL adds a "pr_url:" line to an MR description for a pull request
+// linked with --link-pr. The description is returned unchanged if prURL is
+// empty.
+func appendPRURL(description, prURL string) string {
+	if prURL != "" {
+		return description + "\npr_url: " + prURL
+	}
+	return description
+}
+
+// appendPushRemote adds a "remote:" line to an MR description when the
+// branch was pushed somewhere other than origin, so the Refinery knows where
+// to fetch it from. Origin is the default and is not recorded.
+func appendPushRemote(description, remote string) string {
+	if remote != "" && remote != "origin" {
+		return description + "\nremote: " + remote
+	}
+	return description
+}
+
+// prPathPattern matches the path of a pull or merge request on the common
+// forges: GitHub (/owner/repo/pull/N), GitLab (/group/repo/-/merge_requests/N)
+// and Bitbucket (/owner/repo/pull-requests/N).
+var prPathPattern = regexp.MustCompile(`^/[^/]+/.+/(pull|merge_requests|pull-requests)/[0-9]+/?$`)
+
+// validatePRURL checks that s is an http(s) URL to a pull or merge request.
+func validatePRURL(s string) error {
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return fmt.Errorf("invalid --link-pr %q: must be an http(s) URL", s)
+	}
+	if !prPathPattern.MatchString(u.Path) {
+		return fmt.Errorf("invalid --link-pr %q: not a pull request URL (expected e.g. https://github.com/owner/repo/pull/123)", s)
+	}
+	return nil
+}
+
+// defaultMRTitleTemplate is the MR bead title used when the rig sets no
+// mr_title_template.
+const defaultMRTitleTemplate = "Merge: {{.Issue}}"
+
+// mrTemplateVars are the variables available to mr_title_template and
+// mr_description_template.
+type mrTemplateVars struct {
+	Issue  string // Source issue ID
+	Branch string // Polecat branch being merged
+	Target string // Branch the MR merges into
+	Worker string // Worker address (may be empty)
+	Rig    string // Rig name
+}
+
+// renderMRTitle renders the MR bead title from tmpl, or from the default
+// format when tmpl is empty. If tmpl fails to parse or execute (including
+// references to undefined variables) or renders blank, the default title is
+// returned along with the error so the caller can warn.
+func renderMRTitle(tmpl string, vars mrTemplateVars) (string, error) {
+	def, _ := executeMRTemplate(defaultMRTitleTemplate, vars)
+	if tmpl == "" {
+		return def, nil
+	}
+	title, err := executeMRTemplate(tmpl, vars)
+	if err != nil {
+		return def, err
+	}
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return def, fmt.Errorf("template rendered an empty title")
+	}
+	return title, nil
+}
+
+// renderMRDescription renders the free-form summary of an MR bead description
+// from tmpl. Lines that look like MR fields are dropped so the summary cannot
+// override the structured fields it follows. On error it returns "" (the
+// default format) along with the error.
+func renderMRDescription(tmpl string, vars mrTemplateVars) (string, error) {
+	if tmpl == "" {
+		return "", nil
+	}
+	summary, err := executeMRTemplate(tmpl, vars)
+	if err != nil {
+		return "", err
+	}
+	// With no fields to set, SetMRFields returns just the non-field lines.
+	return beads.SetMRFields(&beads.Issue{Description: summary}, nil), nil
+}
+
+// appendMRSummary adds a rendered template summary after the structured MR
+// fields, separated by a blank line. The fields must come first: beads finds
+// an MR by its leading "branch:" line, and SetMRFields keeps the same order.
+func appendMRSummary(description, summary string) string {
+	if summary != "" {
+		return description + "\n\n" + summary
+	}
+	return description
+}
+
+// executeMRTemplate parses and executes an MR text/template against vars.
+func executeMRTemplate(tmpl string, vars mrTemplateVars) (string, error) {
+	t, err := template.New("mr").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if err := t.Execute(&b, vars); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// polecatBranchTemplate returns the rig's polecat_branch_template setting,
+// or "" when the default polecat branch format is in use.
+func polecatBranchTemplate(townRoot, rigName string, rigCfg *rig.RigConfig) string {
+	r := &rig.Rig{Name: rigName, Path: filepath.Join(townRoot, rigName)}
+	if rigCfg != nil && rigCfg.Beads != nil {
+		r.Config = &config.BeadsConfig{Prefix: rigCfg.Beads.Prefix}
+	}
+	return r.GetStringConfig("polecat_branch_template")
+}
+
+// branchTemplateVar maps polecat_branch_template variables to the pattern
+// they expand to. {issue} and {description} may expand to nothing.
+var branchTemplateVar = map[string]string{
+	"{user}":        `[^/]+`,
+	"{year}":        `[0-9]{2}`,
+	"{month}":       `[0-9]{2}`,
+	"{name}":        `[^/]+`,
+	"{issue}":       `[^/]*`,
+	"{description}": `[^/]*`,
+	"{timestamp}":   `[0-9a-z]+`,
+}
+
+// defaultPolecatBranchPattern matches the default polecat branch formats:
+// polecat/<name>/<issue>[@<timestamp>], polecat/<name>-<timestamp>, and the
+// plain polecat/<name> that polecat creation and gt done's fallback use.
+var defaultPolecatBranchPattern = regexp.MustCompile(`^polecat/([^/]+/[^/@]+(@[0-9a-z]+)?|[^/]+)$`)
+
+// branchMatchesTemplate reports whether branch follows the polecat branch
+// naming convention given by template ("" means the default format).
+func branchMatchesTemplate(branch, template string) bool {
+	if template == "" {
+		return defaultPolecatBranchPattern.MatchString(branch)
+	}
+
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	for rest := template; rest != ""; {
+		open := strings.Index(rest, "{")
+		if open < 0 {
+			pattern.WriteString(regexp.QuoteMeta(rest))
+			break
+		}
+		end := strings.Index(rest[open:], "}")
+		if end < 0 {
+			pattern.WriteString(regexp.QuoteMeta(rest))
+			break
+		}
+		end += open
+		pattern.WriteString(regexp.QuoteMeta(rest[:open]))
+		if expr, ok := branchTemplateVar[rest[open:end+1]]; ok {
+			pattern.WriteString(expr)
+		} else {
+			pattern.WriteString(regexp.QuoteMeta(rest[open : end+1]))
+		}
+		rest = rest[end+1:]
+	}
+	pattern.WriteString("$")
+
+	re, err := regexp.Compile(pattern.String())
+	if err != nil {
+		return true // can't judge; don't warn
+	}
+	return re.MatchString(branch)
+}
+
+// expectedBranchFormat describes the branch naming convention for warnings.
+func expectedBranchFormat(template string) string {
+	if template == "" {
+		return "polecat/<name>/<issue>@<timestamp>"
+	}
+	return template
+}
+
+// resolveWorktreeRoot returns the git toplevel containing cwd, expressed in
+// cwd's own path form (symlinks are not resolved). The toplevel is only used
+// when it lies strictly inside rigPath, so a town-level or mayor repo is
+// never mistaken for the agent's worktree; otherwise cwd is returned as-is.
+func resolveWorktreeRoot(cwd, rigPath string) string {
+	toplevel, err := git.NewGit(cwd).Toplevel()
+	if err != nil || toplevel == "" {
+		return cwd
+	}
+
+	// git reports a symlink-resolved path; compare in resolved form, then
+	// walk cwd up by the same number of levels.
+	realCwd, err := filepath.EvalSymlinks(cwd)
+	if err != nil {
+		return cwd
+	}
+	realRig, err := filepath.EvalSymlinks(rigPath)
+	if err != nil {
+		return cwd
+	}
+	toplevel = filepath.Clean(toplevel)
+	if rel, err := filepath.Rel(realRig, toplevel); err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return cwd
+	}
+	rel, err := filepath.Rel(toplevel, realCwd)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return cwd
+	}
+
+	root := cwd
+	for range strings.Split(rel, string(filepath.Separator)) {
+		root = filepath.Dir(root)
+	}
+	return root
+}
+
+// resolvePushRemote picks the remote gt done pushes the branch to: the
+// --remote flag, then the rig's push_remote setting, then origin.
+func resolvePushRemote(flagRemote string, rigCfg *rig.RigConfig) string {
+	if r := strings.TrimSpace(flagRemote); r != "" {
+		return r
+	}
+	if rigCfg != nil && strings.TrimSpace(rigCfg.PushRemote) != "" {
+		return strings.TrimSpace(rigCfg.PushRemote)
+	}
+	return "origin"
+}
+
 // setDoneIntentLabel writes a done-intent:<type>:<unix-ts> label on the agent bead
 // EARLY in gt done, before push/MR. This allows the Witness to detect polecats that
 // crashed mid-gt-done: if the session is dead but done-intent exists, the polecat was
@@ -1214,6 +1801,12 @@ func updateAgentStateOnDone(cwd, townRoot, exitType, issueID string) {
 		}
 	}
 
+	updateAgentStateForRole(roleInfo, cwd, townRoot, exitType, issueID)
+}
+
+// updateAgentStateForRole is updateAgentStateOnDone with the agent identity
+// already resolved (gt done --for supplies it instead of detecting it).
+func updateAgentStateForRole(roleInfo RoleInfo, cwd, townRoot, exitType, issueID string) {
 	ctx := RoleContext{
 		Role:     roleInfo.Role,
 		Rig:      roleInfo.Rig,
@@ -1371,6 +1964,218 @@ func findHookedBeadForAgent(bd *beads.Beads, agentID string) string {
 	return hookedBeads[0].ID
 }
 
+// recordDoneTimeSpent adds the time the agent spent on the source issue
+// (see doneWorkStart) to its time_spent field. Best-effort: failures only warn.
+func recordDoneTimeSpent(bd *beads.Beads, issueID, sessionName string) {
+	if sessionName == "" {
+		return
+	}
+	sessionStart, err := session.SessionCreatedAt(sessionName)
+	if err != nil {
+		return
+	}
+	issue, _ := bd.Show(issueID)
+	if err := bd.AddTimeSpent(issueID, time.Since(doneWorkStart(sessionStart, issue))); err != nil {
+		style.PrintWarning("could not record time spent on %s: %v", issueID, err)
+	}
+}
+
+// doneWorkStart returns when the agent started on issue: its hooked_at
+// time, or the session start if that is later or hooked_at is missing.
+// Polecat sessions survive gt done and are reused, so the session start
+// alone would charge an issue for earlier assignments too.
+func doneWorkStart(sessionStart time.Time, issue *beads.Issue) time.Time {
+	fields := beads.ParseAttachmentFields(issue)
+	if fields == nil {
+		return sessionStart
+	}
+	if hookedAt, ok := beads.ParseTime(fields.HookedAt); ok && hookedAt.After(sessionStart) {
+		return hookedAt
+	}
+	return sessionStart
+}
+
+// adHocIssueCreator captures the bead operations needed by gt done
+// --create-issue, so the create-and-hook flow can be tested without bd.
+type adHocIssueCreator interface {
+	Create(opts beads.CreateOptions) (*beads.Issue, error)
+	Update(id string, opts beads.UpdateOptions) error
+}
+
+// createAdHocSourceIssue creates a source issue for unplanned work and hooks
+// it to the agent. Hooking matters for retries: a re-run of gt done finds the
+// issue via findHookedBeadForAgent instead of creating a duplicate.
+func createAdHocSourceIssue(bd adHocIssueCreator, title, agent, branch string) (string, error) {
+	description := fmt.Sprintf("Ad hoc work submitted with gt done --create-issue.\nbranch: %s", branch)
+	if agent != "" {
+		description += fmt.Sprintf("\nworker: %s", agent)
+	}
+	issue, err := bd.Create(beads.CreateOptions{
+		Title:       title,
+		Labels:      []string{"gt:task"},
+		Priority:    2,
+		Description: description,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if agent != "" {
+		status := beads.StatusHooked
+		if err := bd.Update(issue.ID, beads.UpdateOptions{Status: &status, Assignee: &agent}); err != nil {
+			// Non-fatal: the issue exists and is usable as the source.
+			style.PrintWarning("could not hook %s to %s: %v", issue.ID, agent, err)
+		}
+	}
+	return issue.ID, nil
+}
+
+// needsHumanLabel marks an issue that gt done --reopen-on-escalate sent
+// back to triage.
+const needsHumanLabel = "needs-human"
+
+// escalatedIssueUpdater captures the bead operations needed by gt done
+// --reopen-on-escalate, so the reopen-and-label flow can be tested without bd.
+type escalatedIssueUpdater interface {
+	Show(id string) (*beads.Issue, error)
+	Update(id string, opts beads.UpdateOptions) error
+}
+
+// reopenEscalatedIssue labels an escalated source issue needs-human and, if
+// it is closed, reopens it so it re-enters triage. Reports whether the issue
+// was reopened.
+func reopenEscalatedIssue(bd escalatedIssueUpdater, issueID string) (bool, error) {
+	issue, err := bd.Show(issueID)
+	if err != nil {
+		return false, err
+	}
+
+	opts := beads.UpdateOptions{AddLabels: []string{needsHumanLabel}}
+	reopened := beads.IssueStatus(issue.Status) == beads.StatusClosed
+	if reopened {
+		status := string(beads.StatusOpen)
+		opts.Status = &status
+	}
+	if err := bd.Update(issueID, opts); err != nil {
+		return false, err
+	}
+	return reopened, nil
+}
+
+// beadCommenter adds comments to beads.
+type beadCommenter interface {
+	AddComment(id, text string) error
+}
+
+// addCommentWithRetry adds a comment to a bead through withBeadsLockRetry,
+// like gt done's other beads writes.
+func addCommentWithRetry(bd beadCommenter, id, text string) error {
+	_, err := withBeadsLockRetry(func() (struct{}, error) {
+		return struct{}{}, bd.AddComment(id, text)
+	})
+	return err
+}
+
+// resumeNoteRecorder captures the bead operations needed by gt done
+// --checkpoint, so the note can be tested without bd.
+type resumeNoteRecorder interface {
+	beadCommenter
+	UpdateAgentDescriptionFields(id string, updates beads.AgentFieldUpdates) error
+}
+
+// recordResumeNote leaves a resumption note for deferred work: a comment on
+// the source issue for whoever picks it up next, and a resume_note field on
+// the agent bead. Either ID may be empty. Failures are warnings, since the
+// deferral itself must still go through.
+func recordResumeNote(bd resumeNoteRecorder, issueID, agentBeadID, sender, note string) {
+	if issueID != "" {
+		comment := "Resume note (deferred"
+		if sender != "" {
+			comment += " by " + sender
+		}
+		comment += "): " + note
+		if err := addCommentWithRetry(bd, issueID, comment); err != nil {
+			style.PrintWarning("could not record resume note on %s: %v", issueID, err)
+		} else {
+			style.Printf("%s Resume note added to %s\n", style.Bold.Render("✓"), issueID)
+		}
+	}
+	if agentBeadID != "" {
+		if err := bd.UpdateAgentDescriptionFields(agentBeadID, beads.AgentFieldUpdates{ResumeNote: &note}); err != nil {
+			style.PrintWarning("could not record resume note on agent bead %s: %v", agentBeadID, err)
+		}
+	}
+}
+
+// doneTarget is the polecat named by gt done --for. Its worktree, address,
+// and session replace the caller's cwd and GT_* environment, so the Witness
+// or a human can finalize the work of a polecat that died mid-task.
+type doneTarget struct {
+	Rig      string
+	Polecat  string
+	Worktree string
+}
+
+// resolveDoneTarget parses a --for value ("<rig>/<polecat>" or
+// "<rig>/polecats/<polecat>") and locates the polecat's worktree: the
+// nested clone polecats/<name>/<rig> if present, otherwise polecats/<name>
+// when it is itself a git checkout.
+func resolveDoneTarget(townRoot, spec string) (*doneTarget, error) {
+	parts := strings.Split(strings.Trim(spec, "/"), "/")
+	if len(parts) == 3 && parts[1] == "polecats" {
+		parts = []string{parts[0], parts[2]}
+	}
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid --for %q: expected <rig>/<polecat>", spec)
+	}
+	rigName, polecatName := parts[0], parts[1]
+
+	polecatDir := filepath.Join(townRoot, rigName, "polecats", polecatName)
+	if _, err := os.Stat(polecatDir); err != nil {
+		return nil, fmt.Errorf("polecat %s/%s not found: %w", rigName, polecatName, err)
+	}
+	worktree := filepath.Join(polecatDir, rigName)
+	if _, err := os.Stat(worktree); err != nil {
+		if _, err := os.Stat(filepath.Join(polecatDir, ".git")); err != nil {
+			return nil, fmt.Errorf("polecat %s/%s has no worktree", rigName, polecatName)
+		}
+		worktree = polecatDir
+	}
+
+	return &doneTarget{Rig: rigName, Polecat: polecatName, Worktree: worktree}, nil
+}
+
+// Address returns the polecat's mail address (<rig>/<polecat>).
+func (t *doneTarget) Address() string {
+	return fmt.Sprintf("%s/%s", t.Rig, t.Polecat)
+}
+
+// SessionName returns the polecat's tmux session name.
+func (t *doneTarget) SessionName() string {
+	return session.PolecatSessionName(session.PrefixFor(t.Rig), t.Polecat)
+}
+
+// RoleInfo returns the polecat identity gt done acts as.
+func (t *doneTarget) RoleInfo(townRoot string) RoleInfo {
+	return RoleInfo{
+		Role:     RolePolecat,
+		Rig:      t.Rig,
+		Polecat:  t.Polecat,
+		TownRoot: townRoot,
+		WorkDir:  t.Worktree,
+		Source:   "--for",
+	}
+}
+
+// doneRoleInfo resolves the agent gt done acts as: the --for target when
+// set, otherwise the caller detected from env and cwd.
+func doneRoleInfo(cwd, townRoot string, target *doneTarget) (RoleInfo, error) {
+	if target != nil {
+		return target.RoleInfo(townRoot), nil
+	}
+	return GetRoleWithContext(cwd, townRoot)
+}
+
 // parseCleanupStatus converts a string flag value to a CleanupStatus.
 // ZFC: Agent observes git state and passes the appropriate status.
 func parseCleanupStatus(s string) polecat.CleanupStatus {
@@ -1521,3 +2326,26 @@ func purgeClosedEphemeralBeads(bd *beads.Beads) {
 		fmt.Fprintf(os.Stderr, "Purged closed ephemeral beads: %s\n", outStr)
 	}
 }
+
+// requireSubstantiveDiff returns an error when branch has no substantive
+// changes against originDefault (or the local default branch if the remote
+// ref is unavailable). If neither comparison works, it warns and allows
+// the submit.
+func requireSubstantiveDiff(g *git.Git, originDefault, defaultBranch, branch string) error {
+	base := originDefault
+	meaningful, err := g.MeaningfulDiff(base, branch)
+	if err != nil {
+		base = defaultBranch
+		meaningful, err = g.MeaningfulDiff(base, branch)
+	}
+	if err != nil {
+		style.PrintWarning("could not check diff against %s or %s: %v", originDefault, defaultBranch, err)
+		return nil
+	}
+	if !meaningful {
+		return fmt.Errorf("cannot complete: diff against %s has only whitespace or generated-file changes\n"+
+			"Make the intended change and commit it, then run gt done again.\n"+
+			"If there is nothing to change: gt done --status DEFERRED", base)
+	}
+	return nil
+}
@@ -1,15 +1,21 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/protocol"
+	"github.com/steveyegge/gastown/internal/rig"
 )
 
 // TestDoneUsesResolveBeadsDir verifies that the done command correctly uses
@@ -1214,3 +1220,1104 @@ func TestHookedBeadCloseNotRestrictedToHookedStatus(t *testing.T) {
 		})
 	}
 }
+
+// TestShouldNotifyDispatcher verifies the dispatcher notification fires only
+// when a dispatcher was recorded and it is not the agent completing the work.
+// lockingBeads is a fake beads client that returns err for its first
+// `failures` calls and succeeds afterwards.
+type lockingBeads struct {
+	failures int
+	err      error
+	calls    int
+}
+
+func (f *lockingBeads) Create(opts beads.CreateOptions) (*beads.Issue, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return nil, f.err
+	}
+	return &beads.Issue{ID: "gt-mr-1", Title: opts.Title}, nil
+}
+
+func (f *lockingBeads) Show(id string) (*beads.Issue, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return nil, f.err
+	}
+	return &beads.Issue{ID: id}, nil
+}
+
+func TestWithBeadsLockRetry(t *testing.T) {
+	oldAttempts, oldBackoff := doneLockRetryAttempts, doneLockRetryBackoff
+	t.Cleanup(func() { doneLockRetryAttempts, doneLockRetryBackoff = oldAttempts, oldBackoff })
+	doneLockRetryAttempts, doneLockRetryBackoff = 3, time.Millisecond
+
+	lockErr := fmt.Errorf("bd create --json: Error: database is locked")
+
+	t.Run("create succeeds after lock", func(t *testing.T) {
+		fake := &lockingBeads{failures: 2, err: lockErr}
+		issue, err := withBeadsLockRetry(func() (*beads.Issue, error) {
+			return fake.Create(beads.CreateOptions{Title: "Merge: gt-abc"})
+		})
+		if err != nil || issue == nil || issue.ID != "gt-mr-1" {
+			t.Fatalf("got %+v, %v; want created MR", issue, err)
+		}
+		if fake.calls != 3 {
+			t.Errorf("calls = %d, want 3", fake.calls)
+		}
+	})
+
+	t.Run("show succeeds after lock", func(t *testing.T) {
+		fake := &lockingBeads{failures: 1, err: fmt.Errorf("bd show gt-mr-1: SQLITE_BUSY")}
+		issue, err := withBeadsLockRetry(func() (*beads.Issue, error) { return fake.Show("gt-mr-1") })
+		if err != nil || issue == nil {
+			t.Fatalf("got %+v, %v; want issue", issue, err)
+		}
+		if fake.calls != 2 {
+			t.Errorf("calls = %d, want 2", fake.calls)
+		}
+	})
+
+	t.Run("gives up after attempts", func(t *testing.T) {
+		fake := &lockingBeads{failures: 10, err: lockErr}
+		_, err := withBeadsLockRetry(func() (*beads.Issue, error) { return fake.Show("gt-mr-1") })
+		if !isBeadsLockError(err) {
+			t.Fatalf("err = %v, want the lock error", err)
+		}
+		if fake.calls != 3 {
+			t.Errorf("calls = %d, want 3", fake.calls)
+		}
+	})
+
+	t.Run("dolt lock wait timeout is retried", func(t *testing.T) {
+		fake := &lockingBeads{failures: 1, err: fmt.Errorf("bd show gt-mr-1: Error 1205 (HY000): Lock wait timeout exceeded")}
+		if _, err := withBeadsLockRetry(func() (*beads.Issue, error) { return fake.Show("gt-mr-1") }); err != nil {
+			t.Fatalf("err = %v, want success after the lock cleared", err)
+		}
+		if fake.calls != 2 {
+			t.Errorf("calls = %d, want 2", fake.calls)
+		}
+	})
+
+	t.Run("typed lock error is retried", func(t *testing.T) {
+		fake := &lockingBeads{failures: 1, err: fmt.Errorf("show gt-mr-1: %w", beads.ErrDBLocked)}
+		issue, err := withBeadsLockRetry(func() (*beads.Issue, error) { return fake.Show("gt-mr-1") })
+		if err != nil || issue == nil {
+			t.Fatalf("got %+v, %v; want issue", issue, err)
+		}
+		if fake.calls != 2 {
+			t.Errorf("calls = %d, want 2", fake.calls)
+		}
+	})
+
+	t.Run("other errors are not retried", func(t *testing.T) {
+		fake := &lockingBeads{failures: 10, err: beads.ErrNotFound}
+		_, err := withBeadsLockRetry(func() (*beads.Issue, error) { return fake.Show("gt-mr-1") })
+		if err != beads.ErrNotFound {
+			t.Fatalf("err = %v, want ErrNotFound", err)
+		}
+		if fake.calls != 1 {
+			t.Errorf("calls = %d, want 1", fake.calls)
+		}
+	})
+}
+
+func TestDispatcherReviewBody(t *testing.T) {
+	body := dispatcherReviewBody(ExitCompleted, "gt-abc", "polecat/nux/gt-abc", "")
+
+	if !strings.HasSuffix(body, "Ready for review.") {
+		t.Errorf("body should end with the human note:\n%s", body)
+	}
+	got := protocol.ParsePolecatDonePayload("nux", body)
+	if got.ExitType != ExitCompleted || got.Issue != "gt-abc" || got.Branch != "polecat/nux/gt-abc" {
+		t.Errorf("ParsePolecatDonePayload = %+v, want exit/issue/branch from the record", got)
+	}
+}
+
+func TestDispatcherReviewBody_LinkedPR(t *testing.T) {
+	body := dispatcherReviewBody(ExitCompleted, "gt-abc", "polecat/nux/gt-abc", "https://github.com/org/repo/pull/42")
+
+	got := protocol.ParsePolecatDonePayload("nux", body)
+	if got.PRURL != "https://github.com/org/repo/pull/42" {
+		t.Errorf("PRURL = %q, want the linked PR", got.PRURL)
+	}
+}
+
+func TestWitnessDoneNudge(t *testing.T) {
+	if got, want := witnessDoneNudge("nux", ExitCompleted, ""), "POLECAT_DONE nux exit=COMPLETED"; got != want {
+		t.Errorf("witnessDoneNudge() = %q, want %q", got, want)
+	}
+	got := witnessDoneNudge("nux", ExitCompleted, "https://github.com/org/repo/pull/42")
+	if want := "POLECAT_DONE nux exit=COMPLETED pr=https://github.com/org/repo/pull/42"; got != want {
+		t.Errorf("witnessDoneNudge() = %q, want %q", got, want)
+	}
+}
+
+func TestShouldNotifyDispatcher(t *testing.T) {
+	tests := []struct {
+		name       string
+		dispatcher string
+		sender     string
+		want       bool
+	}{
+		{"no dispatcher recorded", "", "gastown/polecats/nux", false},
+		{"dispatcher differs", "mayor/", "gastown/polecats/nux", true},
+		{"crew dispatcher differs", "gastown/crew/max", "gastown/polecats/nux", true},
+		{"self-dispatched", "gastown/crew/max", "gastown/crew/max", false},
+		{"self-dispatched trailing slash", "mayor/", "mayor", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := shouldNotifyDispatcher(tt.dispatcher, tt.sender); got != tt.want {
+				t.Errorf("shouldNotifyDispatcher(%q, %q) = %v, want %v", tt.dispatcher, tt.sender, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestResolvePushRemote verifies an explicit --remote overrides the rig's
+// push_remote setting, which in turn overrides the origin default.
+func TestResolvePushRemote(t *testing.T) {
+	tests := []struct {
+		name   string
+		flag   string
+		rigCfg *rig.RigConfig
+		want   string
+	}{
+		{"default origin", "", nil, "origin"},
+		{"rig config without push_remote", "", &rig.RigConfig{DefaultBranch: "main"}, "origin"},
+		{"rig config push_remote", "", &rig.RigConfig{PushRemote: "staging"}, "staging"},
+		{"flag overrides rig config", "review", &rig.RigConfig{PushRemote: "staging"}, "review"},
+		{"flag without rig config", "staging", nil, "staging"},
+		{"whitespace flag ignored", "  ", &rig.RigConfig{PushRemote: "staging"}, "staging"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resolvePushRemote(tt.flag, tt.rigCfg); got != tt.want {
+				t.Errorf("resolvePushRemote(%q) = %q, want %q", tt.flag, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestResolveWorktreeRoot verifies gt done normalizes a nested working
+// directory to the worktree root, but never climbs out of the rig.
+func TestResolveWorktreeRoot(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	townRoot := t.TempDir()
+	rigPath := filepath.Join(townRoot, "gastown")
+	worktree := filepath.Join(rigPath, "polecats", "nux", "gastown")
+	nested := filepath.Join(worktree, "internal", "cmd")
+	if err := os.MkdirAll(nested, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if out, err := exec.Command("git", "init", worktree).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v\n%s", err, out)
+	}
+
+	t.Run("nested subdirectory", func(t *testing.T) {
+		if got := resolveWorktreeRoot(nested, rigPath); got != worktree {
+			t.Errorf("resolveWorktreeRoot(nested) = %q, want %q", got, worktree)
+		}
+	})
+
+	t.Run("already at root", func(t *testing.T) {
+		if got := resolveWorktreeRoot(worktree, rigPath); got != worktree {
+			t.Errorf("resolveWorktreeRoot(root) = %q, want %q", got, worktree)
+		}
+	})
+
+	t.Run("not a git repo", func(t *testing.T) {
+		plain := filepath.Join(rigPath, "crew")
+		if err := os.MkdirAll(plain, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if got := resolveWorktreeRoot(plain, rigPath); got != plain {
+			t.Errorf("resolveWorktreeRoot(plain) = %q, want unchanged", got)
+		}
+	})
+
+	t.Run("toplevel outside rig", func(t *testing.T) {
+		// A repo at the town root must not be treated as the worktree.
+		if out, err := exec.Command("git", "init", townRoot).CombinedOutput(); err != nil {
+			t.Fatalf("git init town: %v\n%s", err, out)
+		}
+		dir := filepath.Join(rigPath, "settings")
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if got := resolveWorktreeRoot(dir, rigPath); got != dir {
+			t.Errorf("resolveWorktreeRoot(dir) = %q, want unchanged", got)
+		}
+	})
+}
+
+// TestAppendDiffStat verifies the MR description gains a parseable diffstat
+// line, and is left untouched when the stat cannot be computed.
+func TestAppendDiffStat(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	run("init", "-b", "main")
+	run("config", "user.email", "test@test.com")
+	run("config", "user.name", "Test User")
+	if err := os.WriteFile(filepath.Join(dir, "a.txt"), []byte("one\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	run("add", ".")
+	run("commit", "-m", "initial")
+	run("checkout", "-b", "polecat/nux/gt-abc")
+	if err := os.WriteFile(filepath.Join(dir, "a.txt"), []byte("two\nthree\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	run("commit", "-am", "work")
+
+	g := git.NewGit(dir)
+	base := "branch: polecat/nux/gt-abc\ntarget: main"
+
+	desc := appendDiffStat(base, g, "main", "polecat/nux/gt-abc")
+	fields := beads.ParseMRFields(&beads.Issue{Description: desc})
+	if fields == nil || fields.DiffStat != "1 file changed, +2 -1" {
+		t.Errorf("description %q: DiffStat = %+v", desc, fields)
+	}
+
+	if got := appendDiffStat(base, g, "origin/main", "polecat/nux/gt-abc"); got != base {
+		t.Errorf("appendDiffStat with unknown base = %q, want unchanged", got)
+	}
+}
+
+func TestRequireSubstantiveDiff(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	run("init", "-b", "main")
+	run("config", "user.email", "test@test.com")
+	run("config", "user.name", "Test User")
+	if err := os.WriteFile(filepath.Join(dir, "a.txt"), []byte("one two\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	run("add", ".")
+	run("commit", "-m", "initial")
+	run("checkout", "-b", "polecat/nux/gt-abc")
+	if err := os.WriteFile(filepath.Join(dir, "a.txt"), []byte("one   two\n\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	run("commit", "-am", "whitespace")
+
+	g := git.NewGit(dir)
+	branch := "polecat/nux/gt-abc"
+
+	// No origin remote: falls back to the local default branch.
+	err := requireSubstantiveDiff(g, "origin/main", "main", branch)
+	if err == nil || !strings.Contains(err.Error(), "only whitespace or generated-file changes") {
+		t.Fatalf("whitespace-only diff: err = %v, want rejection", err)
+	}
+	if strings.Contains(err.Error(), "require-substantive") {
+		t.Errorf("error must not suggest dropping the flag: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "a.txt"), []byte("one three\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	run("commit", "-am", "real change")
+	if err := requireSubstantiveDiff(g, "origin/main", "main", branch); err != nil {
+		t.Errorf("substantive diff: err = %v, want nil", err)
+	}
+
+	// Neither base resolvable: warn and allow.
+	if err := requireSubstantiveDiff(g, "origin/trunk", "trunk", branch); err != nil {
+		t.Errorf("unknown base: err = %v, want nil", err)
+	}
+}
+
+func TestAppendProfile(t *testing.T) {
+	base := "branch: polecat/nux/gt-abc\ntarget: main"
+
+	tests := []struct {
+		name    string
+		profile string
+		agent   string
+		target  *doneTarget
+		want    string
+	}{
+		{"GT_PROFILE set", "fast-review", "claude", nil, "fast-review"},
+		{"falls back to GT_AGENT", "", "codex", nil, "codex"},
+		{"absent", "", "", nil, ""},
+		{"caller's profile not recorded under --for", "fast-review", "claude", &doneTarget{Rig: "gastown", Polecat: "nux"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GT_PROFILE", tt.profile)
+			t.Setenv("GT_AGENT", tt.agent)
+
+			desc := appendProfile(base, doneProfile(tt.target))
+			fields := beads.ParseMRFields(&beads.Issue{Description: desc})
+			if fields == nil {
+				t.Fatalf("description %q has no MR fields", desc)
+			}
+			if fields.Profile != tt.want {
+				t.Errorf("Profile = %q, want %q", fields.Profile, tt.want)
+			}
+			if tt.want == "" && desc != base {
+				t.Errorf("description = %q, want unchanged when no profile is set", desc)
+			}
+		})
+	}
+}
+
+// TestBranchMatchesTemplate verifies branch naming validation against the
+// default polecat format and custom polecat_branch_template patterns.
+func TestBranchMatchesTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		branch   string
+		template string
+		want     bool
+	}{
+		{"default with issue", "polecat/nux/gt-abc", "", true},
+		{"default with issue and timestamp", "polecat/nux/gt-abc@mk123xy", "", true},
+		{"default without issue", "polecat/nux-mk123xy", "", true},
+		{"default plain polecat name", "polecat/nux", "", true},
+		{"default missing polecat prefix", "nux/gt-abc", "", false},
+		{"default free-form branch", "fix-the-thing", "", false},
+		{"default too many segments", "polecat/nux/gt-abc/extra", "", false},
+		{"custom conforming", "jdoe/26/03/abc-fix-login", "{user}/{year}/{month}/{issue}-{description}", true},
+		{"custom wrong year", "jdoe/2026/03/abc-fix-login", "{user}/{year}/{month}/{issue}-{description}", false},
+		{"custom literal prefix", "feature/nux/123", "feature/{name}/{issue}", true},
+		{"custom literal prefix mismatch", "bugfix/nux/123", "feature/{name}/{issue}", false},
+		{"custom regex metacharacters are literal", "work.nux", "work.{name}", true},
+		{"custom dot not wildcard", "workXnux", "work.{name}", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := branchMatchesTemplate(tt.branch, tt.template); got != tt.want {
+				t.Errorf("branchMatchesTemplate(%q, %q) = %v, want %v", tt.branch, tt.template, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestSquashBranchCommits verifies --squash-to collapses unpushed commits
+// into one that references the issue, and refuses to rewrite pushed commits.
+func TestSquashBranchCommits(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	setup := func(t *testing.T) (string, func(args ...string) string) {
+		t.Helper()
+		tmp := t.TempDir()
+		remote := filepath.Join(tmp, "remote.git")
+		dir := filepath.Join(tmp, "work")
+		run := func(args ...string) string {
+			t.Helper()
+			cmd := exec.Command("git", args...)
+			cmd.Dir = dir
+			out, err := cmd.CombinedOutput()
+			if err != nil {
+				t.Fatalf("git %v: %v\n%s", args, err, out)
+			}
+			return strings.TrimSpace(string(out))
+		}
+		if out, err := exec.Command("git", "init", "--bare", remote).CombinedOutput(); err != nil {
+			t.Fatalf("init bare: %v\n%s", err, out)
+		}
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		run("init", "-b", "main")
+		run("config", "user.email", "test@test.com")
+		run("config", "user.name", "Test User")
+		run("remote", "add", "origin", remote)
+		run("commit", "--allow-empty", "-m", "initial")
+		run("push", "origin", "main")
+		run("checkout", "-b", "polecat/nux/gt-abc")
+		for i, name := range []string{"a.txt", "b.txt", "c.txt"} {
+			if err := os.WriteFile(filepath.Join(dir, name), []byte(name), 0644); err != nil {
+				t.Fatal(err)
+			}
+			run("add", name)
+			run("commit", "-m", fmt.Sprintf("step %d", i+1))
+		}
+		return dir, run
+	}
+
+	t.Run("squashes unpushed commits", func(t *testing.T) {
+		dir, run := setup(t)
+		if err := squashBranchCommits(git.NewGit(dir), 2, 3, "gt-abc"); err != nil {
+			t.Fatalf("squashBranchCommits: %v", err)
+		}
+		if got := run("rev-list", "--count", "main..HEAD"); got != "2" {
+			t.Errorf("commits ahead after squash = %s, want 2", got)
+		}
+		msg := run("log", "-1", "--format=%B")
+		if !strings.HasPrefix(msg, "step 2 (gt-abc)") || !strings.Contains(msg, "Squashed 2 commits") {
+			t.Errorf("squash message = %q", msg)
+		}
+		if got := run("ls-files"); got != "a.txt\nb.txt\nc.txt" {
+			t.Errorf("tree after squash = %q, want all files kept", got)
+		}
+	})
+
+	t.Run("refuses already-pushed commits", func(t *testing.T) {
+		dir, run := setup(t)
+		run("push", "origin", "HEAD~1:refs/heads/polecat/nux/gt-abc")
+		run("fetch", "origin")
+		head := run("rev-parse", "HEAD")
+
+		err := squashBranchCommits(git.NewGit(dir), 2, 3, "gt-abc")
+		if err == nil || !strings.Contains(err.Error(), "already pushed") {
+			t.Fatalf("squashBranchCommits error = %v, want already-pushed refusal", err)
+		}
+		if got := run("rev-parse", "HEAD"); got != head {
+			t.Errorf("HEAD moved after refusal: %s -> %s", head, got)
+		}
+	})
+
+	t.Run("refuses uncommitted changes", func(t *testing.T) {
+		dir, run := setup(t)
+		if err := os.WriteFile(filepath.Join(dir, "a.txt"), []byte("staged edit"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		run("add", "a.txt")
+		head := run("rev-parse", "HEAD")
+
+		err := squashBranchCommits(git.NewGit(dir), 2, 3, "gt-abc")
+		if err == nil || !strings.Contains(err.Error(), "uncommitted changes") {
+			t.Fatalf("squashBranchCommits error = %v, want uncommitted-changes refusal", err)
+		}
+		if got := run("rev-parse", "HEAD"); got != head {
+			t.Errorf("HEAD moved after refusal: %s -> %s", head, got)
+		}
+		if got := run("diff", "--cached", "--name-only"); got != "a.txt" {
+			t.Errorf("staged files = %q, want a.txt left staged", got)
+		}
+	})
+
+	t.Run("ignores untracked files", func(t *testing.T) {
+		dir, run := setup(t)
+		if err := os.WriteFile(filepath.Join(dir, "scratch.txt"), []byte("notes"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := squashBranchCommits(git.NewGit(dir), 2, 3, "gt-abc"); err != nil {
+			t.Fatalf("squashBranchCommits: %v", err)
+		}
+		if got := run("ls-files"); got != "a.txt\nb.txt\nc.txt" {
+			t.Errorf("tree after squash = %q, want untracked file left out", got)
+		}
+	})
+
+	t.Run("refuses squashing past the branch base", func(t *testing.T) {
+		dir, _ := setup(t)
+		if err := squashBranchCommits(git.NewGit(dir), 4, 3, "gt-abc"); err == nil {
+			t.Error("expected error when N exceeds commits ahead")
+		}
+		if err := squashBranchCommits(git.NewGit(dir), 1, 3, "gt-abc"); err == nil {
+			t.Error("expected error for N < 2")
+		}
+	})
+}
+
+// fakeAdHocIssueCreator records the bead operations made by createAdHocSourceIssue.
+type fakeAdHocIssueCreator struct {
+	created   []beads.CreateOptions
+	updated   map[string]beads.UpdateOptions
+	createErr error
+	updateErr error
+}
+
+func (f *fakeAdHocIssueCreator) Create(opts beads.CreateOptions) (*beads.Issue, error) {
+	if f.createErr != nil {
+		return nil, f.createErr
+	}
+	f.created = append(f.created, opts)
+	return &beads.Issue{ID: fmt.Sprintf("gt-adhoc%d", len(f.created)), Title: opts.Title}, nil
+}
+
+func (f *fakeAdHocIssueCreator) Update(id string, opts beads.UpdateOptions) error {
+	if f.updateErr != nil {
+		return f.updateErr
+	}
+	if f.updated == nil {
+		f.updated = make(map[string]beads.UpdateOptions)
+	}
+	f.updated[id] = opts
+	return nil
+}
+
+func TestCreateAdHocSourceIssue(t *testing.T) {
+	t.Run("creates and hooks issue to agent", func(t *testing.T) {
+		bd := &fakeAdHocIssueCreator{}
+		id, err := createAdHocSourceIssue(bd, "Fix typo in README", "gastown/polecats/nux", "polecat/nux/adhoc")
+		if err != nil {
+			t.Fatalf("createAdHocSourceIssue: %v", err)
+		}
+		if id != "gt-adhoc1" {
+			t.Errorf("id = %q, want gt-adhoc1", id)
+		}
+
+		if len(bd.created) != 1 {
+			t.Fatalf("expected 1 create, got %d", len(bd.created))
+		}
+		opts := bd.created[0]
+		if opts.Title != "Fix typo in README" {
+			t.Errorf("Title = %q", opts.Title)
+		}
+		if len(opts.Labels) != 1 || opts.Labels[0] != "gt:task" {
+			t.Errorf("Labels = %v, want [gt:task]", opts.Labels)
+		}
+		if opts.Ephemeral {
+			t.Error("source issue must not be ephemeral")
+		}
+		for _, want := range []string{"branch: polecat/nux/adhoc", "worker: gastown/polecats/nux"} {
+			if !strings.Contains(opts.Description, want) {
+				t.Errorf("Description missing %q:\n%s", want, opts.Description)
+			}
+		}
+
+		// Hooked to the agent so a retried gt done finds it via findHookedBeadForAgent.
+		upd, ok := bd.updated[id]
+		if !ok {
+			t.Fatal("issue was not hooked")
+		}
+		if upd.Status == nil || *upd.Status != beads.StatusHooked {
+			t.Errorf("Status = %v, want hooked", upd.Status)
+		}
+		if upd.Assignee == nil || *upd.Assignee != "gastown/polecats/nux" {
+			t.Errorf("Assignee = %v, want gastown/polecats/nux", upd.Assignee)
+		}
+	})
+
+	t.Run("no agent skips hooking", func(t *testing.T) {
+		bd := &fakeAdHocIssueCreator{}
+		if _, err := createAdHocSourceIssue(bd, "Ad hoc", "", "feature"); err != nil {
+			t.Fatalf("createAdHocSourceIssue: %v", err)
+		}
+		if len(bd.updated) != 0 {
+			t.Errorf("expected no update without an agent, got %v", bd.updated)
+		}
+	})
+
+	t.Run("hook failure still returns issue", func(t *testing.T) {
+		bd := &fakeAdHocIssueCreator{updateErr: fmt.Errorf("db locked")}
+		id, err := createAdHocSourceIssue(bd, "Ad hoc", "gastown/polecats/nux", "feature")
+		if err != nil || id != "gt-adhoc1" {
+			t.Errorf("got (%q, %v), want (gt-adhoc1, nil)", id, err)
+		}
+	})
+
+	t.Run("create failure is returned", func(t *testing.T) {
+		bd := &fakeAdHocIssueCreator{createErr: fmt.Errorf("bd unavailable")}
+		if _, err := createAdHocSourceIssue(bd, "Ad hoc", "gastown/polecats/nux", "feature"); err == nil {
+			t.Error("expected create error")
+		}
+	})
+}
+
+// fakeEscalatedIssues is an in-memory escalatedIssueUpdater that applies
+// status and label updates.
+type fakeEscalatedIssues struct {
+	issues    map[string]*beads.Issue
+	updateErr error
+}
+
+func (f *fakeEscalatedIssues) Show(id string) (*beads.Issue, error) {
+	issue, ok := f.issues[id]
+	if !ok {
+		return nil, fmt.Errorf("issue %s not found", id)
+	}
+	return issue, nil
+}
+
+func (f *fakeEscalatedIssues) Update(id string, opts beads.UpdateOptions) error {
+	if f.updateErr != nil {
+		return f.updateErr
+	}
+	issue := f.issues[id]
+	if opts.Status != nil {
+		issue.Status = *opts.Status
+	}
+	issue.Labels = append(issue.Labels, opts.AddLabels...)
+	return nil
+}
+
+func TestReopenEscalatedIssue(t *testing.T) {
+	t.Run("reopens and labels a closed issue", func(t *testing.T) {
+		f := &fakeEscalatedIssues{issues: map[string]*beads.Issue{
+			"gt-abc": {ID: "gt-abc", Status: "closed", Labels: []string{"gt:task"}},
+		}}
+		reopened, err := reopenEscalatedIssue(f, "gt-abc")
+		if err != nil {
+			t.Fatalf("reopenEscalatedIssue: %v", err)
+		}
+		if !reopened {
+			t.Error("reopened = false, want true for a closed issue")
+		}
+		issue := f.issues["gt-abc"]
+		if issue.Status != "open" {
+			t.Errorf("status = %q, want open", issue.Status)
+		}
+		if !slices.Contains(issue.Labels, needsHumanLabel) || !slices.Contains(issue.Labels, "gt:task") {
+			t.Errorf("labels = %v, want gt:task and %s", issue.Labels, needsHumanLabel)
+		}
+	})
+
+	t.Run("labels an open issue without changing status", func(t *testing.T) {
+		f := &fakeEscalatedIssues{issues: map[string]*beads.Issue{
+			"gt-abc": {ID: "gt-abc", Status: beads.StatusHooked},
+		}}
+		reopened, err := reopenEscalatedIssue(f, "gt-abc")
+		if err != nil || reopened {
+			t.Fatalf("reopenEscalatedIssue = %v, %v; want false, nil", reopened, err)
+		}
+		issue := f.issues["gt-abc"]
+		if issue.Status != beads.StatusHooked {
+			t.Errorf("status = %q, want unchanged", issue.Status)
+		}
+		if !slices.Contains(issue.Labels, needsHumanLabel) {
+			t.Errorf("labels = %v, want %s", issue.Labels, needsHumanLabel)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		if _, err := reopenEscalatedIssue(&fakeEscalatedIssues{}, "gt-missing"); err == nil {
+			t.Error("expected error for unknown issue")
+		}
+		f := &fakeEscalatedIssues{
+			issues:    map[string]*beads.Issue{"gt-abc": {ID: "gt-abc", Status: "closed"}},
+			updateErr: errors.New("database is locked"),
+		}
+		if reopened, err := reopenEscalatedIssue(f, "gt-abc"); err == nil || reopened {
+			t.Errorf("reopenEscalatedIssue = %v, %v; want update error", reopened, err)
+		}
+	})
+}
+
+// fakeResumeNoteBeads records the bead operations made by recordResumeNote.
+// The first commentFailures comments fail with commentErr.
+type fakeResumeNoteBeads struct {
+	comments        [][2]string
+	updates         map[string]beads.AgentFieldUpdates
+	commentErr      error
+	commentFailures int
+	commentCalls    int
+}
+
+func (f *fakeResumeNoteBeads) AddComment(id, text string) error {
+	f.commentCalls++
+	if f.commentCalls <= f.commentFailures {
+		return f.commentErr
+	}
+	f.comments = append(f.comments, [2]string{id, text})
+	return nil
+}
+
+func (f *fakeResumeNoteBeads) UpdateAgentDescriptionFields(id string, updates beads.AgentFieldUpdates) error {
+	if f.updates == nil {
+		f.updates = make(map[string]beads.AgentFieldUpdates)
+	}
+	f.updates[id] = updates
+	return nil
+}
+
+func TestRecordResumeNote(t *testing.T) {
+	const note = "parser done; flags next"
+
+	t.Run("writes both beads", func(t *testing.T) {
+		f := &fakeResumeNoteBeads{}
+		recordResumeNote(f, "gt-abc", "gt-gastown-polecat-nux", "gastown/polecats/nux", note)
+
+		if len(f.comments) != 1 || f.comments[0][0] != "gt-abc" {
+			t.Fatalf("comments = %v, want one on gt-abc", f.comments)
+		}
+		if text := f.comments[0][1]; !strings.Contains(text, note) || !strings.Contains(text, "gastown/polecats/nux") {
+			t.Errorf("comment = %q, want the note and sender", text)
+		}
+
+		upd, ok := f.updates["gt-gastown-polecat-nux"]
+		if !ok || upd.ResumeNote == nil || *upd.ResumeNote != note {
+			t.Fatalf("agent bead updates = %+v, want ResumeNote %q", f.updates, note)
+		}
+		if upd.ExitType != nil || upd.CompletionTime != nil {
+			t.Errorf("resume note must not touch other completion fields: %+v", upd)
+		}
+	})
+
+	t.Run("skips missing beads", func(t *testing.T) {
+		f := &fakeResumeNoteBeads{}
+		recordResumeNote(f, "", "", "", note)
+		if len(f.comments) != 0 || len(f.updates) != 0 {
+			t.Errorf("comments = %v, updates = %v; want none", f.comments, f.updates)
+		}
+	})
+
+	t.Run("retries a locked comment", func(t *testing.T) {
+		oldAttempts, oldBackoff := doneLockRetryAttempts, doneLockRetryBackoff
+		t.Cleanup(func() { doneLockRetryAttempts, doneLockRetryBackoff = oldAttempts, oldBackoff })
+		doneLockRetryAttempts, doneLockRetryBackoff = 3, time.Millisecond
+
+		f := &fakeResumeNoteBeads{commentErr: errors.New("database is locked"), commentFailures: 2}
+		recordResumeNote(f, "gt-abc", "", "", note)
+		if len(f.comments) != 1 || f.commentCalls != 3 {
+			t.Errorf("comments = %v after %d calls, want one after 3", f.comments, f.commentCalls)
+		}
+	})
+
+	t.Run("comment failure still updates agent bead", func(t *testing.T) {
+		f := &fakeResumeNoteBeads{commentErr: errors.New("no issue found"), commentFailures: 1}
+		recordResumeNote(f, "gt-abc", "gt-gastown-polecat-nux", "", note)
+		if _, ok := f.updates["gt-gastown-polecat-nux"]; !ok {
+			t.Error("agent bead not updated after comment failure")
+		}
+	})
+}
+
+func TestResolveDoneTarget(t *testing.T) {
+	townRoot := t.TempDir()
+
+	// nux uses the nested clone layout, toast is a flat checkout, and
+	// ghost has a polecat directory but no worktree.
+	nested := filepath.Join(townRoot, "gastown", "polecats", "nux", "gastown")
+	flat := filepath.Join(townRoot, "gastown", "polecats", "toast")
+	for _, dir := range []string{nested, filepath.Join(flat, ".git"), filepath.Join(townRoot, "gastown", "polecats", "ghost")} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		spec         string
+		wantWorktree string
+		wantPolecat  string
+		wantErr      string
+	}{
+		{spec: "gastown/nux", wantWorktree: nested, wantPolecat: "nux"},
+		{spec: "gastown/polecats/nux", wantWorktree: nested, wantPolecat: "nux"},
+		{spec: "gastown/toast", wantWorktree: flat, wantPolecat: "toast"},
+		{spec: "gastown/ghost", wantErr: "has no worktree"},
+		{spec: "gastown/missing", wantErr: "not found"},
+		{spec: "nux", wantErr: "expected <rig>/<polecat>"},
+		{spec: "gastown/crew/joe", wantErr: "expected <rig>/<polecat>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			target, err := resolveDoneTarget(townRoot, tt.spec)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("resolveDoneTarget(%q) error = %v, want %q", tt.spec, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveDoneTarget(%q): %v", tt.spec, err)
+			}
+			if target.Rig != "gastown" || target.Polecat != tt.wantPolecat || target.Worktree != tt.wantWorktree {
+				t.Errorf("target = %+v, want rig gastown, polecat %s, worktree %s", target, tt.wantPolecat, tt.wantWorktree)
+			}
+		})
+	}
+}
+
+func TestDoneTargetIdentity(t *testing.T) {
+	// The caller is the Witness; --for must act as the target polecat.
+	t.Setenv("GT_ROLE", "gastown/witness")
+	t.Setenv("GT_RIG", "gastown")
+	t.Setenv("GT_POLECAT", "")
+
+	townRoot := t.TempDir()
+	target := &doneTarget{Rig: "gastown", Polecat: "nux", Worktree: filepath.Join(townRoot, "gastown", "polecats", "nux", "gastown")}
+
+	if got := target.Address(); got != "gastown/nux" {
+		t.Errorf("Address() = %q, want gastown/nux", got)
+	}
+	if !strings.HasSuffix(target.SessionName(), "nux") {
+		t.Errorf("SessionName() = %q, want the nux polecat session", target.SessionName())
+	}
+
+	roleInfo, err := doneRoleInfo(townRoot, townRoot, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if roleInfo.Role != RolePolecat || roleInfo.Rig != "gastown" || roleInfo.Polecat != "nux" || roleInfo.WorkDir != target.Worktree {
+		t.Errorf("doneRoleInfo with target = %+v, want polecat gastown/nux", roleInfo)
+	}
+
+	// Without a target the caller's own identity is used.
+	roleInfo, err = doneRoleInfo(townRoot, townRoot, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if roleInfo.Role != RoleWitness {
+		t.Errorf("doneRoleInfo without target = %+v, want the witness caller", roleInfo)
+	}
+}
+
+func TestRenderMRTitle(t *testing.T) {
+	vars := mrTemplateVars{Issue: "gt-abc", Branch: "polecat/nux/gt-abc", Target: "main", Worker: "gastown/polecats/nux", Rig: "gastown"}
+
+	tests := []struct {
+		name    string
+		tmpl    string
+		want    string
+		wantErr bool
+	}{
+		{name: "default", tmpl: "", want: "Merge: gt-abc"},
+		{name: "custom", tmpl: "[{{.Rig}}] {{.Issue}} → {{.Target}}", want: "[gastown] gt-abc → main"},
+		{name: "undefined variable", tmpl: "{{.Ticket}}", want: "Merge: gt-abc", wantErr: true},
+		{name: "parse error", tmpl: "{{.Issue", want: "Merge: gt-abc", wantErr: true},
+		{name: "blank", tmpl: "{{if false}}x{{end}}", want: "Merge: gt-abc", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := renderMRTitle(tt.tmpl, vars)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("title = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderMRDescription(t *testing.T) {
+	vars := mrTemplateVars{Issue: "gt-abc", Branch: "polecat/nux/gt-abc", Target: "main", Worker: "gastown/polecats/nux", Rig: "gastown"}
+
+	got, err := renderMRDescription("", vars)
+	if err != nil || got != "" {
+		t.Errorf("default = %q, %v; want empty", got, err)
+	}
+
+	got, err = renderMRDescription("Fixes {{.Issue}}\nAuthor: {{.Worker}}\n", vars)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Fixes gt-abc\nAuthor: gastown/polecats/nux"; got != want {
+		t.Errorf("custom = %q, want %q", got, want)
+	}
+
+	got, err = renderMRDescription("See {{.Ticket}}", vars)
+	if err == nil {
+		t.Error("expected error for undefined variable")
+	}
+	if got != "" {
+		t.Errorf("undefined variable = %q, want fallback to empty summary", got)
+	}
+}
+
+func TestRenderMRDescription_KeepsStructuredFields(t *testing.T) {
+	vars := mrTemplateVars{Issue: "gt-abc", Branch: "polecat/nux/gt-abc", Target: "main", Rig: "gastown"}
+	summary, err := renderMRDescription("branch: decoy\n{{.Issue}} ready", vars)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary != "gt-abc ready" {
+		t.Errorf("summary = %q, want field lines dropped", summary)
+	}
+	description := fmt.Sprintf("branch: %s\ntarget: %s\nsource_issue: %s\nrig: %s", vars.Branch, vars.Target, vars.Issue, vars.Rig)
+	description = appendMRSummary(description, summary)
+
+	fields := beads.ParseMRFields(&beads.Issue{Description: description})
+	if fields == nil || fields.Branch != vars.Branch || fields.Target != "main" || fields.SourceIssue != "gt-abc" {
+		t.Errorf("ParseMRFields = %+v, want structured fields to win over summary text", fields)
+	}
+}
+
+// mrRoundTripBDScript is a bd stub that remembers the description of the
+// last created bead and returns it from list, so an MR can be created and
+// then looked up again by branch.
+const mrRoundTripBDScript = `#!/bin/sh
+cmd=""
+for arg in "$@"; do
+  case "$arg" in --*) ;; *) cmd="$arg"; break ;; esac
+done
+case "$cmd" in
+  create)
+    for arg in "$@"; do
+      case "$arg" in --description=*) printf '%s' "${arg#--description=}" > "$MOCK_MR_STATE/desc" ;; esac
+    done
+    echo '{"id":"gt-mr1","title":"Merge: gt-abc","status":"open"}'
+    ;;
+  list)
+    if [ -f "$MOCK_MR_STATE/desc" ]; then
+      desc=$(awk 'BEGIN{ORS=""} { gsub(/\\/,"\\\\"); gsub(/"/,"\\\""); if (NR>1) print "\\n"; print }' "$MOCK_MR_STATE/desc")
+      printf '[{"id":"gt-mr1","title":"Merge: gt-abc","status":"open","description":"%s"}]\n' "$desc"
+    else
+      echo '[]'
+    fi
+    ;;
+esac
+exit 0
+`
+
+func TestMRDescriptionTemplate_FindMRForBranch(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell script bd stub not supported on Windows")
+	}
+	binDir := t.TempDir()
+	writeBDStub(t, binDir, mrRoundTripBDScript, "")
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("MOCK_MR_STATE", t.TempDir())
+
+	workDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(workDir, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	bd := beads.NewIsolated(workDir)
+
+	vars := mrTemplateVars{Issue: "gt-abc", Branch: "polecat/nux/gt-abc", Target: "main", Rig: "gastown"}
+	summary, err := renderMRDescription("Fixes {{.Issue}}\n\nSee the \"{{.Rig}}\" tracker.", vars)
+	if err != nil {
+		t.Fatal(err)
+	}
+	description := fmt.Sprintf("branch: %s\ntarget: %s\nsource_issue: %s\nrig: %s", vars.Branch, vars.Target, vars.Issue, vars.Rig)
+	description = appendMRSummary(description, summary)
+	if _, err := bd.Create(beads.CreateOptions{
+		Title:       "Merge: gt-abc",
+		Labels:      []string{"gt:merge-request"},
+		Description: description,
+		Ephemeral:   true,
+	}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	mr, err := bd.FindMRForBranch(vars.Branch)
+	if err != nil {
+		t.Fatalf("FindMRForBranch: %v", err)
+	}
+	if mr == nil || mr.ID != "gt-mr1" {
+		t.Fatalf("FindMRForBranch = %+v, want gt-mr1", mr)
+	}
+	if !strings.Contains(mr.Description, "Fixes gt-abc") {
+		t.Errorf("summary missing from description: %q", mr.Description)
+	}
+}
+
+func TestValidatePRURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		wantErr bool
+	}{
+		{"https://github.com/org/repo/pull/42", false},
+		{"https://github.com/org/repo/pull/42/", false},
+		{"https://gitlab.com/group/sub/repo/-/merge_requests/7", false},
+		{"https://bitbucket.org/org/repo/pull-requests/3", false},
+		{"http://git.internal/org/repo/pull/1", false},
+		{"", true},
+		{"github.com/org/repo/pull/42", true},
+		{"ftp://github.com/org/repo/pull/42", true},
+		{"https://github.com/org/repo", true},
+		{"https://github.com/org/repo/pull/abc", true},
+		{"https://github.com/org/repo/issues/42", true},
+		{"not a url", true},
+	}
+	for _, tt := range tests {
+		err := validatePRURL(tt.url)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("validatePRURL(%q) error = %v, wantErr %v", tt.url, err, tt.wantErr)
+		}
+	}
+}
+
+func TestAppendPushRemote(t *testing.T) {
+	base := "branch: polecat/nux/gt-abc\ntarget: main"
+
+	for _, remote := range []string{"", "origin"} {
+		if got := appendPushRemote(base, remote); got != base {
+			t.Errorf("appendPushRemote(base, %q) = %q, want unchanged", remote, got)
+		}
+	}
+
+	desc := appendPushRemote(base, "staging")
+	fields := beads.ParseMRFields(&beads.Issue{Description: desc})
+	if fields == nil || fields.PushRemote() != "staging" {
+		t.Fatalf("ParseMRFields(%q) = %+v, want remote staging", desc, fields)
+	}
+	if got := beads.ParseMRFields(&beads.Issue{Description: base}).PushRemote(); got != "origin" {
+		t.Errorf("PushRemote() without remote = %q, want origin", got)
+	}
+}
+
+func TestAppendPRURL(t *testing.T) {
+	base := "branch: polecat/nux/gt-abc\ntarget: main"
+
+	if got := appendPRURL(base, ""); got != base {
+		t.Errorf("appendPRURL(base, \"\") = %q, want unchanged", got)
+	}
+
+	desc := appendPRURL(base, "https://github.com/org/repo/pull/42")
+	fields := beads.ParseMRFields(&beads.Issue{Description: desc})
+	if fields == nil || fields.PRURL != "https://github.com/org/repo/pull/42" {
+		t.Fatalf("ParseMRFields(%q) = %+v, want pr_url set", desc, fields)
+	}
+	if fields.Branch != "polecat/nux/gt-abc" {
+		t.Errorf("Branch = %q, want other fields preserved", fields.Branch)
+	}
+}
+
+func TestDoneWorkStart(t *testing.T) {
+	sessionStart := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	hookedAt := sessionStart.Add(5 * time.Hour)
+
+	tests := []struct {
+		name  string
+		issue *beads.Issue
+		want  time.Time
+	}{
+		{
+			name:  "session predates the hook",
+			issue: &beads.Issue{Description: "Fix it.\n\nhooked_at: " + hookedAt.Format(time.RFC3339)},
+			want:  hookedAt,
+		},
+		{
+			name:  "hooked before the session started",
+			issue: &beads.Issue{Description: "hooked_at: " + sessionStart.Add(-time.Hour).Format(time.RFC3339)},
+			want:  sessionStart,
+		},
+		{
+			name:  "no hooked_at",
+			issue: &beads.Issue{Description: "dispatched_by: mayor/"},
+			want:  sessionStart,
+		},
+		{
+			name:  "unparseable hooked_at",
+			issue: &beads.Issue{Description: "hooked_at: soon"},
+			want:  sessionStart,
+		},
+		{
+			name: "issue unavailable",
+			want: sessionStart,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := doneWorkStart(sessionStart, tt.issue); !got.Equal(tt.want) {
+				t.Errorf("doneWorkStart = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
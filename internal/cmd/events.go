@@ -0,0 +1,214 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	eventsTailTypes    []string
+	eventsSummarySince string
+	eventsSummaryJSON  bool
+)
+
+var eventsCmd = &cobra.Command{
+	Use:     "events",
+	GroupID: GroupDiag,
+	Short:   "Inspect the raw event log",
+	Long: `Inspect the raw event log (~/gt/.events.jsonl).
+
+Unlike 'gt feed', which shows the curated feed-visible events, these
+commands read every event, including audit-only ones.`,
+	RunE: requireSubcommand,
+}
+
+var eventsTailCmd = &cobra.Command{
+	Use:   "tail",
+	Short: "Follow new events as they are logged",
+	Long: `Follow the event log like tail -f, printing each new event as it is
+appended. Existing events are not replayed. Press Ctrl-C to stop.
+
+Use --type to show only some event types. It may be repeated or given a
+comma-separated list.
+
+Examples:
+  gt events tail                       # Everything, as it happens
+  gt events tail --type done           # Only gt done completions
+  gt events tail --type merged,merge_failed`,
+	Args: cobra.NoArgs,
+	RunE: runEventsTail,
+}
+
+var eventsSummaryCmd = &cobra.Command{
+	Use:   "summary",
+	Short: "Summarize events logged since a time",
+	Long: `Summarize the event log since a cutoff: how many events of each type
+were logged, followed by the notable ones (completed work, escalations,
+failed merges, closed convoys, and mass session deaths).
+
+--since accepts a duration back from now, an RFC3339 time, or a local
+date (YYYY-MM-DD). The default is the last 24 hours.
+
+Examples:
+  gt events summary                    # Last 24 hours
+  gt events summary --since 2h
+  gt events summary --since 2026-01-02
+  gt events summary --since 8h --json`,
+	Args: cobra.NoArgs,
+	RunE: runEventsSummary,
+}
+
+func init() {
+	eventsTailCmd.Flags().StringSliceVar(&eventsTailTypes, "type", nil, "Only show events of these types (repeatable, comma-separated)")
+	eventsSummaryCmd.Flags().StringVar(&eventsSummarySince, "since", "24h", "Start of the summary (duration, RFC3339 time, or YYYY-MM-DD)")
+	eventsSummaryCmd.Flags().BoolVar(&eventsSummaryJSON, "json", false, "Output as JSON")
+	eventsCmd.AddCommand(eventsTailCmd)
+	eventsCmd.AddCommand(eventsSummaryCmd)
+	rootCmd.AddCommand(eventsCmd)
+}
+
+func runEventsTail(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	path := filepath.Join(townRoot, events.EventsFile)
+	fmt.Fprintf(os.Stderr, "%s\n", style.Dim.Render("Following "+path+" (Ctrl-C to stop)"))
+	return tailEvents(ctx, path, eventsTailTypes, events.DefaultFollowInterval, os.Stdout)
+}
+
+// tailEvents follows the event log at path and writes each new event whose
+// type is in types (all types when empty) to w.
+func tailEvents(ctx context.Context, path string, types []string, interval time.Duration, w io.Writer) error {
+	wanted := make(map[string]bool, len(types))
+	for _, t := range types {
+		if t = strings.TrimSpace(t); t != "" {
+			wanted[t] = true
+		}
+	}
+	return events.Follow(ctx, path, interval, func(e events.Event) {
+		if len(wanted) > 0 && !wanted[e.Type] {
+			return
+		}
+		fmt.Fprintln(w, formatTailEvent(e))
+	})
+}
+
+// formatTailEvent renders an event as one line:
+//
+//	[15:04:05] done            gastown/nux  bead=gt-abc branch=polecat/nux
+//
+// Payload keys are sorted so output is stable.
+func formatTailEvent(e events.Event) string {
+	ts := e.Timestamp
+	if t, err := time.Parse(time.RFC3339, e.Timestamp); err == nil {
+		ts = t.Local().Format("15:04:05")
+	}
+	actor := e.Actor
+	if actor == "" {
+		actor = "system"
+	}
+
+	keys := make([]string, 0, len(e.Payload))
+	for k := range e.Payload {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	fields := make([]string, 0, len(keys))
+	for _, k := range keys {
+		fields = append(fields, fmt.Sprintf("%s=%v", k, e.Payload[k]))
+	}
+
+	return strings.TrimRight(fmt.Sprintf("[%s] %-16s %-24s %s", ts, e.Type, actor, strings.Join(fields, " ")), " ")
+}
+
+func runEventsSummary(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	since, err := parseEventsSince(eventsSummarySince, time.Now())
+	if err != nil {
+		return err
+	}
+
+	summary, err := events.Summarize(filepath.Join(townRoot, events.EventsFile), since)
+	if err != nil {
+		return fmt.Errorf("reading event log: %w", err)
+	}
+
+	if eventsSummaryJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(summary)
+	}
+	printEventSummary(os.Stdout, summary)
+	return nil
+}
+
+// parseEventsSince parses a --since value: a non-negative duration back from
+// now, an RFC3339 time, or a local date (YYYY-MM-DD, meaning its midnight).
+func parseEventsSince(s string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		if d < 0 {
+			return time.Time{}, fmt.Errorf("invalid --since %q: duration must not be negative", s)
+		}
+		return now.Add(-d), nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", s, now.Location()); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid --since %q: expected duration (e.g. 24h), RFC3339 time, or YYYY-MM-DD", s)
+}
+
+// printEventSummary writes a summary as per-type counts, most frequent
+// first, followed by the notable events in log order.
+func printEventSummary(w io.Writer, s *events.EventSummary) {
+	fmt.Fprintf(w, "%s since %s\n\n", style.Bold.Render(fmt.Sprintf("%d event(s)", s.Total)), s.Since.Local().Format("2006-01-02 15:04 MST"))
+	if s.Total == 0 {
+		return
+	}
+
+	types := make([]string, 0, len(s.Counts))
+	for t := range s.Counts {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		if s.Counts[types[i]] != s.Counts[types[j]] {
+			return s.Counts[types[i]] > s.Counts[types[j]]
+		}
+		return types[i] < types[j]
+	})
+	for _, t := range types {
+		fmt.Fprintf(w, "  %6d  %s\n", s.Counts[t], t)
+	}
+
+	if len(s.Notable) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\n%s\n", style.Bold.Render("Notable:"))
+	for _, e := range s.Notable {
+		fmt.Fprintf(w, "  %s\n", formatTailEvent(e))
+	}
+}
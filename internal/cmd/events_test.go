@@ -0,0 +1,200 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+// syncBuffer is a bytes.Buffer safe for the tail goroutine to write while
+// the test reads.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func appendTestEvent(t *testing.T, path string, e events.Event) {
+	t.Helper()
+	data, err := json.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// runTail starts tailEvents on path and returns its output buffer and a
+// stop func that waits for it to exit.
+func runTail(t *testing.T, path string, types []string) (*syncBuffer, func()) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	out := &syncBuffer{}
+	done := make(chan error, 1)
+	go func() { done <- tailEvents(ctx, path, types, 5*time.Millisecond, out) }()
+	time.Sleep(20 * time.Millisecond)
+	return out, func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("tailEvents: %v", err)
+		}
+	}
+}
+
+func waitForOutput(t *testing.T, out *syncBuffer, substr string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if strings.Contains(out.String(), substr) {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %q in output %q", substr, out.String())
+}
+
+func TestTailEvents_PrintsAppendedEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), events.EventsFile)
+	appendTestEvent(t, path, events.Event{Type: events.TypeSling, Actor: "mayor/", Payload: events.SlingPayload("gt-old", "gastown/nux")})
+
+	out, stop := runTail(t, path, nil)
+	appendTestEvent(t, path, events.Event{
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Type:      events.TypeDone,
+		Actor:     "gastown/nux",
+		Payload:   events.DonePayload("gt-abc", "polecat/nux"),
+	})
+	waitForOutput(t, out, "gt-abc")
+	stop()
+
+	got := out.String()
+	if strings.Contains(got, "gt-old") {
+		t.Errorf("tail replayed an existing event: %q", got)
+	}
+	for _, want := range []string{"done", "gastown/nux", "bead=gt-abc", "branch=polecat/nux"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output missing %q: %q", want, got)
+		}
+	}
+}
+
+func TestTailEvents_FiltersByType(t *testing.T) {
+	path := filepath.Join(t.TempDir(), events.EventsFile)
+	out, stop := runTail(t, path, []string{"merged", " merge_failed"})
+
+	appendTestEvent(t, path, events.Event{Type: events.TypeSling, Actor: "mayor/", Payload: map[string]interface{}{"bead": "gt-sling"}})
+	appendTestEvent(t, path, events.Event{Type: events.TypeMergeFailed, Actor: "gastown/refinery", Payload: map[string]interface{}{"mr": "gt-mr-2"}})
+	appendTestEvent(t, path, events.Event{Type: events.TypeMerged, Actor: "gastown/refinery", Payload: map[string]interface{}{"mr": "gt-mr-1"}})
+	waitForOutput(t, out, "gt-mr-1")
+	stop()
+
+	got := out.String()
+	if strings.Contains(got, "gt-sling") {
+		t.Errorf("filtered-out sling event was printed: %q", got)
+	}
+	if !strings.Contains(got, "gt-mr-2") {
+		t.Errorf("merge_failed event missing: %q", got)
+	}
+}
+
+func TestFormatTailEvent(t *testing.T) {
+	got := formatTailEvent(events.Event{Timestamp: "not-a-time", Type: "kill", Payload: map[string]interface{}{"z": 1, "a": "x"}})
+	if !strings.HasPrefix(got, "[not-a-time] kill") {
+		t.Errorf("unparseable timestamp should be shown raw: %q", got)
+	}
+	if !strings.Contains(got, "system") {
+		t.Errorf("empty actor should render as system: %q", got)
+	}
+	if !strings.HasSuffix(got, "a=x z=1") {
+		t.Errorf("payload should be sorted key=value pairs: %q", got)
+	}
+}
+
+func TestParseEventsSince(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		in      string
+		want    time.Time
+		wantErr bool
+	}{
+		{in: "24h", want: now.Add(-24 * time.Hour)},
+		{in: "0s", want: now},
+		{in: "2026-01-01T09:00:00Z", want: time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)},
+		{in: "2026-01-01", want: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{in: "-1h", wantErr: true},
+		{in: "yesterday", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseEventsSince(tt.in, now)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseEventsSince(%q) = %v, want error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("parseEventsSince(%q) = %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestPrintEventSummary(t *testing.T) {
+	done := events.Event{Timestamp: "2026-01-01T10:00:00Z", Type: events.TypeDone, Actor: "gastown/nux", Payload: map[string]interface{}{"bead": "gt-abc"}}
+	s := &events.EventSummary{
+		Since:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Total:   6,
+		Counts:  map[string]int{"sling": 2, "nudge": 2, "done": 1, "escalation_sent": 1},
+		Notable: []events.Event{done},
+	}
+
+	var buf bytes.Buffer
+	printEventSummary(&buf, s)
+	got := buf.String()
+
+	if !strings.Contains(got, "6 event(s)") {
+		t.Errorf("missing total: %q", got)
+	}
+	// Ties sort by type name, so nudge precedes sling.
+	order := []string{"nudge", "sling", "done", "escalation_sent", "Notable:", "bead=gt-abc"}
+	last := -1
+	for _, want := range order {
+		i := strings.Index(got, want)
+		if i <= last {
+			t.Fatalf("%q missing or out of order in:\n%s", want, got)
+		}
+		last = i
+	}
+}
+
+func TestPrintEventSummary_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	printEventSummary(&buf, &events.EventSummary{Counts: map[string]int{}})
+	if strings.Contains(buf.String(), "Notable") {
+		t.Errorf("empty summary should print only the header: %q", buf.String())
+	}
+}
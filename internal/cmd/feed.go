@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
@@ -66,6 +67,8 @@ The feed combines multiple event sources:
   - Beads activity: Issue creates, updates, completions (from bd activity, when available)
   - Convoy status: In-progress and recently-landed convoys (refreshes every 10s)
 
+In the TUI, --since also sets the recently-landed window (default 24h).
+
 Use --plain for simple text output (reads .events.jsonl directly).
 
 Tmux Integration:
@@ -103,6 +106,7 @@ Examples:
   gt feed --plain               # Plain text output (bd activity)
   gt feed --window              # Open in dedicated tmux window
   gt feed --since 1h            # Events from last hour
+  gt feed --since 168h          # TUI: show convoys landed in the last week
   gt feed --rig greenplace      # Use gastown rig's beads`,
 	RunE: runFeed,
 }
@@ -277,9 +281,17 @@ func runFeedTUI(workDir string, problemsView bool) error {
 	}
 	m.SetEventChannel(multiSource.Events())
 	m.SetTownRoot(townRoot)
+	if feedSince != "" {
+		window, err := time.ParseDuration(feedSince)
+		if err != nil {
+			return fmt.Errorf("invalid --since duration %q: %w", feedSince, err)
+		}
+		m.SetLandedWindow(window)
+	}
 
-	// Run the TUI
+	// Run the TUI, then wait for in-flight convoy fetches to wind down
 	p := tea.NewProgram(m, tea.WithAltScreen())
+	defer m.Stop()
 	if _, err := p.Run(); err != nil {
 		return fmt.Errorf("running TUI: %w", err)
 	}
@@ -119,6 +119,7 @@ Examples:
   gt nudge witness "Check polecat health"
   gt nudge deacon session-started
   gt nudge channel:workers "New priority work available"
+  gt nudge --rig gastown --idle   # Propulsion nudge to idle polecats with hooked work
 
   # Use --stdin for messages with special characters or formatting:
   gt nudge gastown/alpha --stdin <<'EOF'
@@ -126,7 +127,7 @@ Examples:
   - Task 1: complete
   - Task 2: in progress
   EOF`,
-	Args: cobra.RangeArgs(1, 2),
+	Args: cobra.RangeArgs(0, 2),
 	RunE: runNudge,
 }
 
@@ -338,7 +339,9 @@ var validNudgePriorities = map[string]bool{
 func runNudge(cmd *cobra.Command, args []string) (retErr error) {
 	defer func() {
 		target := ""
-		if len(args) > 0 {
+		if nudgeRigFlag != "" {
+			target = "rig:" + nudgeRigFlag
+		} else if len(args) > 0 {
 			target = args[0]
 		}
 		telemetry.RecordNudge(context.Background(), target, retErr)
@@ -368,7 +371,18 @@ func runNudge(cmd *cobra.Command, args []string) (retErr error) {
 		}
 	}
 
-	target := args[0]
+	// --rig/--idle: batch-nudge a rig's idle polecats; the optional
+	// argument is the message.
+	if nudgeRigFlag != "" || nudgeIdleFlag {
+		if nudgeRigFlag == "" || !nudgeIdleFlag {
+			return fmt.Errorf("--rig and --idle must be used together")
+		}
+		if len(args) > 1 {
+			return fmt.Errorf("--rig takes at most one argument (the message)")
+		}
+	} else if len(args) == 0 {
+		return fmt.Errorf("target required: gt nudge <target> [message]")
+	}
 
 	// Handle --stdin: read message from stdin (avoids shell quoting issues)
 	if nudgeStdinFlag {
@@ -382,6 +396,19 @@ func runNudge(cmd *cobra.Command, args []string) (retErr error) {
 		nudgeMessageFlag = strings.TrimRight(string(data), "\n")
 	}
 
+	// Identify sender for message prefix (needed before channel check)
+	sender := nudgeSender()
+
+	if nudgeRigFlag != "" {
+		message := nudgeMessageFlag
+		if message == "" && len(args) == 1 {
+			message = args[0]
+		}
+		return runNudgeIdlePolecats(nudgeRigFlag, message, sender)
+	}
+
+	target := args[0]
+
 	// Get message from -m flag or positional arg
 	var message string
 	if nudgeMessageFlag != "" {
@@ -392,27 +419,6 @@ func runNudge(cmd *cobra.Command, args []string) (retErr error) {
 		return fmt.Errorf("message required: use -m flag or provide as second argument")
 	}
 
-	// Identify sender for message prefix (needed before channel check)
-	sender := "unknown"
-	if roleInfo, err := GetRole(); err == nil {
-		switch roleInfo.Role {
-		case RoleMayor:
-			sender = constants.RoleMayor
-		case RoleCrew:
-			sender = fmt.Sprintf("%s/crew/%s", roleInfo.Rig, roleInfo.Polecat)
-		case RolePolecat:
-			sender = fmt.Sprintf("%s/%s", roleInfo.Rig, roleInfo.Polecat)
-		case RoleWitness:
-			sender = fmt.Sprintf("%s/witness", roleInfo.Rig)
-		case RoleRefinery:
-			sender = fmt.Sprintf("%s/refinery", roleInfo.Rig)
-		case RoleDeacon:
-			sender = constants.RoleDeacon
-		default:
-			sender = string(roleInfo.Role)
-		}
-	}
-
 	// Handle channel syntax: channel:<name>
 	if strings.HasPrefix(target, "channel:") {
 		channelName := strings.TrimPrefix(target, "channel:")
@@ -588,8 +594,33 @@ func runNudge(cmd *cobra.Command, args []string) (retErr error) {
 	return nil
 }
 
+// nudgeSender returns the caller's address for the nudge message prefix.
+func nudgeSender() string {
+	roleInfo, err := GetRole()
+	if err != nil {
+		return "unknown"
+	}
+	switch roleInfo.Role {
+	case RoleMayor:
+		return constants.RoleMayor
+	case RoleCrew:
+		return fmt.Sprintf("%s/crew/%s", roleInfo.Rig, roleInfo.Polecat)
+	case RolePolecat:
+		return fmt.Sprintf("%s/%s", roleInfo.Rig, roleInfo.Polecat)
+	case RoleWitness:
+		return fmt.Sprintf("%s/witness", roleInfo.Rig)
+	case RoleRefinery:
+		return fmt.Sprintf("%s/refinery", roleInfo.Rig)
+	case RoleDeacon:
+		return constants.RoleDeacon
+	default:
+		return string(roleInfo.Role)
+	}
+}
+
 // runNudgeChannel nudges all members of a named channel.
 // Routes each target through deliverNudge so --mode is respected.
+// sender is the caller's address, as returned by nudgeSender.
 func runNudgeChannel(channelName, message, sender string) error {
 	// Find town root
 	townRoot, err := workspace.FindFromCwdOrError()
@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/cli"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	nudgeRigFlag  string
+	nudgeIdleFlag bool
+)
+
+func init() {
+	nudgeCmd.Flags().StringVar(&nudgeRigFlag, "rig", "", "Nudge polecats in this rig (requires --idle)")
+	nudgeCmd.Flags().BoolVar(&nudgeIdleFlag, "idle", false, "With --rig: nudge only idle polecats that have hooked work")
+}
+
+// propulsionNudge is the default message for gt nudge --rig --idle: it tells
+// an idle polecat to pick up the work already on its hook.
+func propulsionNudge() string {
+	return "Work is on your hook. Run `" + cli.Name() + " hook` to see it, then begin - no questions, just start."
+}
+
+// listRigAgentBeads returns a rig's agent beads keyed by bead ID.
+// Tests override this variable to avoid shelling out to bd.
+var listRigAgentBeads = func(townRoot, rigName string) (map[string]*beads.Issue, error) {
+	return beads.New(filepath.Join(townRoot, rigName, "mayor", "rig")).ListAgentBeads()
+}
+
+// nudgeSessionRunning reports whether a polecat session exists.
+// Tests override this variable to avoid tmux.
+var nudgeSessionRunning = func(sessionName string) bool {
+	ok, _ := tmux.NewTmux().HasSession(sessionName)
+	return ok
+}
+
+// idlePolecat is a polecat selected by gt nudge --rig --idle.
+type idlePolecat struct {
+	Name     string
+	Session  string
+	HookBead string
+}
+
+// selectIdleHookedPolecats returns the rig's polecats whose agent bead is
+// idle with work on the hook and whose session is running, sorted by name.
+func selectIdleHookedPolecats(rigName string, agents map[string]*beads.Issue, running func(string) bool) []idlePolecat {
+	prefix := session.PrefixFor(rigName)
+	var selected []idlePolecat
+	for id, issue := range agents {
+		if issue == nil {
+			continue
+		}
+		agentRig, role, name, ok := beads.ParseAgentBeadID(id)
+		if !ok || agentRig != rigName || role != constants.RolePolecat || name == "" {
+			continue
+		}
+
+		state, hook := issue.AgentState, issue.HookBead
+		if fields := beads.ParseAgentFields(issue.Description); fields != nil {
+			if state == "" {
+				state = fields.AgentState
+			}
+			if hook == "" {
+				hook = fields.HookBead
+			}
+		}
+		if beads.AgentState(state) != beads.AgentStateIdle || hook == "" {
+			continue
+		}
+
+		sessionName := session.PolecatSessionName(prefix, name)
+		if !running(sessionName) {
+			continue
+		}
+		selected = append(selected, idlePolecat{Name: name, Session: sessionName, HookBead: hook})
+	}
+	sort.Slice(selected, func(i, j int) bool { return selected[i].Name < selected[j].Name })
+	return selected
+}
+
+// runNudgeIdlePolecats nudges every idle polecat in a rig that has hooked
+// work. An empty message sends the propulsion nudge.
+func runNudgeIdlePolecats(rigName, message, sender string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	_ = session.InitRegistry(townRoot)
+
+	agents, err := listRigAgentBeads(townRoot, rigName)
+	if err != nil {
+		return fmt.Errorf("listing agent beads for %s: %w", rigName, err)
+	}
+
+	targets := selectIdleHookedPolecats(rigName, agents, nudgeSessionRunning)
+	if len(targets) == 0 {
+		fmt.Printf("%s No idle polecats with hooked work in %s\n", style.Dim.Render("○"), rigName)
+		return nil
+	}
+
+	if message == "" {
+		message = propulsionNudge()
+	}
+
+	t := tmux.NewTmux()
+	var succeeded, failed, skipped int
+
+	fmt.Printf("Nudging %d idle polecat(s) in %s (mode=%s)...\n\n", len(targets), rigName, nudgeModeFlag)
+
+	for i, p := range targets {
+		addr := rigName + "/" + p.Name
+		if !nudgeForceFlag {
+			if shouldSend, level, _ := shouldNudgeTarget(townRoot, addr, false); !shouldSend {
+				skipped++
+				fmt.Printf("  %s %s (DND: %s)\n", style.Dim.Render("○"), addr, level)
+				continue
+			}
+		}
+
+		if err := deliverNudge(t, p.Session, message, sender); err != nil {
+			failed++
+			fmt.Printf("  %s %s: %v\n", style.ErrorPrefix, addr, err)
+		} else {
+			succeeded++
+			fmt.Printf("  %s %s %s\n", style.SuccessPrefix, addr, style.Dim.Render("(hook: "+p.HookBead+")"))
+			_ = events.LogFeed(events.TypeNudge, sender, events.NudgePayload(rigName, addr, message))
+		}
+
+		// Small delay between nudges
+		if i < len(targets)-1 {
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+
+	fmt.Println()
+	summary := fmt.Sprintf("%d polecat(s) nudged", succeeded)
+	if skipped > 0 {
+		summary += fmt.Sprintf(", %d skipped (DND)", skipped)
+	}
+	if failed > 0 {
+		fmt.Printf("%s %s, %d failed\n", style.WarningPrefix, summary, failed)
+		return fmt.Errorf("%d nudge(s) failed", failed)
+	}
+	fmt.Printf("%s %s\n", style.SuccessPrefix, summary)
+	return nil
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestSelectIdleHookedPolecats(t *testing.T) {
+	agents := map[string]*beads.Issue{
+		// idle with hook: selected
+		"gt-gastown-polecat-nux": {ID: "gt-gastown-polecat-nux", AgentState: "idle", HookBead: "gt-work1"},
+		// idle with hook from description fields: selected
+		"gt-gastown-polecat-slit": {ID: "gt-gastown-polecat-slit", Description: "agent_state: idle\nhook_bead: gt-work2"},
+		// idle without hook: skipped
+		"gt-gastown-polecat-rust": {ID: "gt-gastown-polecat-rust", AgentState: "idle"},
+		// working with hook: skipped
+		"gt-gastown-polecat-toast": {ID: "gt-gastown-polecat-toast", AgentState: "working", HookBead: "gt-work3"},
+		// idle with hook but no session: skipped
+		"gt-gastown-polecat-furi": {ID: "gt-gastown-polecat-furi", AgentState: "idle", HookBead: "gt-work4"},
+		// not a polecat: skipped
+		"gt-gastown-witness": {ID: "gt-gastown-witness", AgentState: "idle", HookBead: "gt-work5"},
+		// other rig: skipped
+		"gt-beads-polecat-max":   {ID: "gt-beads-polecat-max", AgentState: "idle", HookBead: "gt-work6"},
+		"gt-gastown-polecat-nil": nil,
+	}
+	running := func(sessionName string) bool {
+		return !strings.HasSuffix(sessionName, "furi")
+	}
+
+	got := selectIdleHookedPolecats("gastown", agents, running)
+
+	var names []string
+	for _, p := range got {
+		names = append(names, p.Name+"="+p.HookBead)
+	}
+	want := "nux=gt-work1,slit=gt-work2"
+	if strings.Join(names, ",") != want {
+		t.Errorf("selected = %v, want %s", names, want)
+	}
+	for _, p := range got {
+		if !strings.HasSuffix(p.Session, p.Name) {
+			t.Errorf("session %q does not name polecat %q", p.Session, p.Name)
+		}
+	}
+}
+
+func TestRunNudge_RigRequiresIdle(t *testing.T) {
+	origRig, origIdle := nudgeRigFlag, nudgeIdleFlag
+	t.Cleanup(func() { nudgeRigFlag, nudgeIdleFlag = origRig, origIdle })
+
+	nudgeRigFlag, nudgeIdleFlag = "gastown", false
+	err := runNudge(nudgeCmd, nil)
+	if err == nil || !strings.Contains(err.Error(), "--rig and --idle") {
+		t.Errorf("runNudge(--rig without --idle) error = %v", err)
+	}
+}
@@ -1,8 +1,10 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"sort"
@@ -19,6 +21,7 @@ import (
 // Plugin command flags
 var (
 	pluginListJSON     bool
+	pluginListJSONL    bool
 	pluginShowJSON     bool
 	pluginRunForce     bool
 	pluginRunDryRun    bool
@@ -27,6 +30,7 @@ var (
 	pluginSyncSource   string
 	pluginSyncClean    bool
 	pluginSyncDryRun   bool
+	pluginCheckJSON    bool
 )
 
 var pluginCmd = &cobra.Command{
@@ -51,6 +55,7 @@ GATE TYPES:
 Examples:
   gt plugin list                    # List all discovered plugins
   gt plugin show <name>             # Show plugin details
+  gt plugin check                   # Validate all plugins
   gt plugin list --json             # JSON output`,
 	RunE: requireSubcommand,
 }
@@ -68,7 +73,8 @@ When a plugin exists at both levels, the rig-level version takes precedence.
 
 Examples:
   gt plugin list              # Human-readable output
-  gt plugin list --json       # JSON output for scripting`,
+  gt plugin list --json       # JSON output for scripting
+  gt plugin list --jsonl      # One JSON object per line, for piping`,
 	RunE: runPluginList,
 }
 
@@ -135,9 +141,31 @@ Examples:
 	RunE: runPluginHistory,
 }
 
+var pluginCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Validate all plugins",
+	Long: `Validate every plugin in town and rig plugin directories.
+
+Checks that each plugin.md parses, that gate settings are well-formed
+(cooldown durations, cron schedules, condition commands, event names),
+that execution settings are valid, and that referenced files such as
+run.sh exist. All problems are reported at once.
+
+Town-level plugins shadowed by a rig-level plugin of the same name are
+checked too.
+
+Exits non-zero if any plugin has a problem.
+
+Examples:
+  gt plugin check             # Human-readable report
+  gt plugin check --json      # JSON output for scripting`,
+	RunE: runPluginCheck,
+}
+
 func init() {
 	// List subcommand flags
 	pluginListCmd.Flags().BoolVar(&pluginListJSON, "json", false, "Output as JSON")
+	pluginListCmd.Flags().BoolVar(&pluginListJSONL, "jsonl", false, "Output as JSON lines (one plugin per line)")
 
 	// Show subcommand flags
 	pluginShowCmd.Flags().BoolVar(&pluginShowJSON, "json", false, "Output as JSON")
@@ -155,12 +183,16 @@ func init() {
 	pluginSyncCmd.Flags().BoolVar(&pluginSyncClean, "clean", false, "Remove plugins from target that don't exist in source")
 	pluginSyncCmd.Flags().BoolVar(&pluginSyncDryRun, "dry-run", false, "Show what would happen without syncing")
 
+	// Check subcommand flags
+	pluginCheckCmd.Flags().BoolVar(&pluginCheckJSON, "json", false, "Output as JSON")
+
 	// Add subcommands
 	pluginCmd.AddCommand(pluginListCmd)
 	pluginCmd.AddCommand(pluginShowCmd)
 	pluginCmd.AddCommand(pluginRunCmd)
 	pluginCmd.AddCommand(pluginHistoryCmd)
 	pluginCmd.AddCommand(pluginSyncCmd)
+	pluginCmd.AddCommand(pluginCheckCmd)
 
 	rootCmd.AddCommand(pluginCmd)
 }
@@ -191,6 +223,10 @@ func getPluginScanner() (*plugin.Scanner, string, error) {
 }
 
 func runPluginList(cmd *cobra.Command, args []string) error {
+	if pluginListJSON && pluginListJSONL {
+		return fmt.Errorf("--json and --jsonl are mutually exclusive")
+	}
+
 	scanner, townRoot, err := getPluginScanner()
 	if err != nil {
 		return err
@@ -209,8 +245,27 @@ func runPluginList(cmd *cobra.Command, args []string) error {
 	if pluginListJSON {
 		return outputPluginListJSON(plugins)
 	}
+	if pluginListJSONL {
+		return outputPluginListJSONL(os.Stdout, plugins)
+	}
 
-	return outputPluginListText(plugins, townRoot)
+	if err := outputPluginListText(plugins, townRoot); err != nil {
+		return err
+	}
+	printShadowedPlugins(scanner.Shadowed())
+	return nil
+}
+
+// printShadowedPlugins notes plugins hidden by a same-named rig-level plugin.
+func printShadowedPlugins(shadowed []*plugin.Plugin) {
+	if len(shadowed) == 0 {
+		return
+	}
+	fmt.Printf("  %s\n", style.Bold.Render("Shadowed:"))
+	for _, p := range shadowed {
+		fmt.Printf("    %s\n", style.Dim.Render(fmt.Sprintf("%s:%s shadowed by %s", p.Origin(), p.Name, p.ShadowedBy)))
+	}
+	fmt.Println()
 }
 
 func outputPluginListJSON(plugins []*plugin.Plugin) error {
@@ -224,6 +279,19 @@ func outputPluginListJSON(plugins []*plugin.Plugin) error {
 	return enc.Encode(summaries)
 }
 
+// outputPluginListJSONL writes one compact PluginSummary per line. Each line
+// is encoded and written on its own, so consumers can process plugins as they
+// arrive instead of parsing a single array.
+func outputPluginListJSONL(w io.Writer, plugins []*plugin.Plugin) error {
+	enc := json.NewEncoder(w)
+	for _, p := range plugins {
+		if err := enc.Encode(p.Summary()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func outputPluginListText(plugins []*plugin.Plugin, townRoot string) error {
 	if len(plugins) == 0 {
 		fmt.Printf("%s No plugins discovered\n", style.Dim.Render("○"))
@@ -296,6 +364,52 @@ func printPluginSummary(p *plugin.Plugin) {
 	}
 }
 
+func runPluginCheck(cmd *cobra.Command, args []string) error {
+	scanner, _, err := getPluginScanner()
+	if err != nil {
+		return err
+	}
+
+	result, err := scanner.Check()
+	if err != nil {
+		return fmt.Errorf("checking plugins: %w", err)
+	}
+
+	if pluginCheckJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(result); err != nil {
+			return err
+		}
+	} else {
+		outputPluginCheckText(result)
+	}
+
+	if !result.OK() {
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+func outputPluginCheckText(result *plugin.CheckResult) {
+	if result.OK() {
+		fmt.Printf("%s All %d plugin(s) valid\n", style.Success.Render("✓"), result.Checked)
+		return
+	}
+
+	fmt.Printf("%s %d problem(s) in %d plugin(s) checked\n\n",
+		style.Error.Render("✗"), len(result.Problems), result.Checked)
+
+	for _, p := range result.Problems {
+		loc := string(p.Location)
+		if p.RigName != "" {
+			loc = p.RigName
+		}
+		fmt.Printf("  %s %s %s\n", style.Error.Render("!"), style.Bold.Render(p.Plugin), style.Dim.Render("["+loc+"]"))
+		fmt.Printf("      %s\n", p.Message)
+	}
+}
+
 func runPluginShow(cmd *cobra.Command, args []string) error {
 	name := args[0]
 
@@ -422,7 +536,7 @@ func runPluginRun(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	// Check gate status for cooldown gates
+	// Check gate status for cooldown and condition gates
 	gateOpen := true
 	gateReason := ""
 	if p.Gate != nil && p.Gate.Type == plugin.GateCooldown && !pluginRunForce {
@@ -440,6 +554,11 @@ func runPluginRun(cmd *cobra.Command, args []string) error {
 			gateReason = fmt.Sprintf("ran %d time(s) within %s cooldown", count, duration)
 		}
 	}
+	if p.Gate != nil && p.Gate.Type == plugin.GateCondition && !pluginRunForce {
+		result := plugin.EvaluateCondition(context.Background(), p)
+		gateOpen = result.Open
+		gateReason = result.Reason
+	}
 
 	if pluginRunDryRun {
 		fmt.Printf("%s Dry run for plugin: %s\n", style.Bold.Render("Plugin:"), p.Name)
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/plugin"
+)
+
+func TestOutputPluginListJSONL(t *testing.T) {
+	plugins := []*plugin.Plugin{
+		{Name: "alpha", Description: "First\nplugin", Location: plugin.LocationTown, Path: "/town/plugins/alpha"},
+		{Name: "beta", Location: plugin.LocationRig, RigName: "gastown", Path: "/town/gastown/plugins/beta",
+			Gate: &plugin.Gate{Type: plugin.GateCooldown, Duration: "1h"}},
+	}
+
+	var buf bytes.Buffer
+	if err := outputPluginListJSONL(&buf, plugins); err != nil {
+		t.Fatalf("outputPluginListJSONL: %v", err)
+	}
+
+	var got []plugin.PluginSummary
+	sc := bufio.NewScanner(&buf)
+	for sc.Scan() {
+		var s plugin.PluginSummary
+		if err := json.Unmarshal(sc.Bytes(), &s); err != nil {
+			t.Fatalf("line %d does not parse on its own: %v\n%s", len(got)+1, err, sc.Text())
+		}
+		got = append(got, s)
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != len(plugins) {
+		t.Fatalf("got %d lines, want %d", len(got), len(plugins))
+	}
+	for i, p := range plugins {
+		if got[i] != p.Summary() {
+			t.Errorf("line %d = %+v, want %+v", i+1, got[i], p.Summary())
+		}
+	}
+	if got[1].GateType != plugin.GateCooldown || got[1].RigName != "gastown" {
+		t.Errorf("rig plugin summary incomplete: %+v", got[1])
+	}
+}
+
+func TestOutputPluginListJSONL_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := outputPluginListJSONL(&buf, nil); err != nil {
+		t.Fatalf("outputPluginListJSONL: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected no output for no plugins, got %q", buf.String())
+	}
+}
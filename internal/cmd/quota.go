@@ -233,7 +233,7 @@ func runQuotaScan(cmd *cobra.Command, args []string) error {
 
 	// Create scanner
 	t := ttmux.NewTmux()
-	scanner, err := quota.NewScanner(t, nil, acctCfg)
+	scanner, err := newQuotaScanner(t, acctCfg)
 	if err != nil {
 		return fmt.Errorf("creating scanner: %w", err)
 	}
@@ -290,10 +290,24 @@ func printScanJSON(results []quota.ScanResult) error {
 
 func printScanText(results []quota.ScanResult) error {
 	limited := 0
+	exhausted := 0
 	nearLimit := 0
 
 	for _, r := range results {
-		if r.RateLimited {
+		if r.QuotaExhausted {
+			exhausted++
+			account := r.AccountHandle
+			if account == "" {
+				account = "(unknown)"
+			}
+			fmt.Printf(" %s %-25s %s %s%s\n",
+				style.Error.Render("$"),
+				r.Session,
+				style.Dim.Render("account:"),
+				account,
+				style.Dim.Render(" quota exhausted (check billing)"),
+			)
+		} else if r.RateLimited {
 			limited++
 			account := r.AccountHandle
 			if account == "" {
@@ -330,7 +344,7 @@ func printScanText(results []quota.ScanResult) error {
 		}
 	}
 
-	if limited == 0 && nearLimit == 0 {
+	if limited == 0 && exhausted == 0 && nearLimit == 0 {
 		fmt.Printf(" %s No rate-limited sessions detected (%d scanned)\n",
 			style.SuccessPrefix, len(results))
 	} else {
@@ -339,6 +353,9 @@ func printScanText(results []quota.ScanResult) error {
 		if limited > 0 {
 			parts = append(parts, fmt.Sprintf("%d limited", limited))
 		}
+		if exhausted > 0 {
+			parts = append(parts, fmt.Sprintf("%d quota exhausted", exhausted))
+		}
 		if nearLimit > 0 {
 			parts = append(parts, fmt.Sprintf("%d near-limit", nearLimit))
 		}
@@ -410,7 +427,7 @@ func runQuotaRotate(cmd *cobra.Command, args []string) error {
 
 	// Create scanner and plan rotation
 	t := ttmux.NewTmux()
-	scanner, err := quota.NewScanner(t, nil, acctCfg)
+	scanner, err := newQuotaScanner(t, acctCfg)
 	if err != nil {
 		return fmt.Errorf("creating scanner: %w", err)
 	}
@@ -643,6 +660,22 @@ func runQuotaClear(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// newQuotaScanner creates a scanner with the built-in rate-limit patterns
+// plus any rate_limit_patterns from accounts.json. Invalid extra patterns
+// are reported as warnings and skipped.
+func newQuotaScanner(t quota.TmuxClient, acctCfg *config.AccountsConfig) (*quota.Scanner, error) {
+	scanner, err := quota.NewScanner(t, nil, acctCfg)
+	if err != nil {
+		return nil, err
+	}
+	if acctCfg != nil {
+		for _, err := range scanner.AddPatterns(acctCfg.RateLimitPatterns) {
+			style.PrintWarning("accounts.json rate_limit_patterns: %v", err)
+		}
+	}
+	return scanner, nil
+}
+
 // accountHandles returns sorted account handle names for error messages.
 func accountHandles(acctCfg *config.AccountsConfig) []string {
 	handles := make([]string, 0, len(acctCfg.Accounts))
@@ -863,7 +896,7 @@ func runQuotaWatch(cmd *cobra.Command, args []string) error {
 
 func runWatchCycle(townRoot string, acctCfg *config.AccountsConfig) {
 	t := ttmux.NewTmux()
-	scanner, err := quota.NewScanner(t, nil, acctCfg)
+	scanner, err := newQuotaScanner(t, acctCfg)
 	if err != nil {
 		style.PrintWarning("creating scanner: %v", err)
 		return
@@ -0,0 +1,241 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	reconcileRig    string
+	reconcileDryRun bool
+)
+
+var reconcileCmd = &cobra.Command{
+	Use:     "reconcile",
+	GroupID: GroupDiag,
+	Short:   "Repair agent bead states that disagree with live sessions",
+	Long: `Compare each rig agent bead's agent_state against its tmux session and
+hooked work, and correct obvious mismatches.
+
+An agent bead that says it is working (working, running, or spawning)
+but whose session is dead is corrected to:
+  - stuck, if work is still hooked to the agent
+  - idle, if nothing is hooked
+
+Intentional states (stuck, escalated, awaiting-gate, done, nuked) are
+never changed.
+
+Examples:
+  gt reconcile                  # All rigs
+  gt reconcile --rig gastown    # One rig
+  gt reconcile --dry-run        # Report without changing beads`,
+	Args: cobra.NoArgs,
+	RunE: runReconcile,
+}
+
+func init() {
+	reconcileCmd.Flags().StringVar(&reconcileRig, "rig", "", "Only reconcile this rig")
+	reconcileCmd.Flags().BoolVar(&reconcileDryRun, "dry-run", false, "Show corrections without applying them")
+	rootCmd.AddCommand(reconcileCmd)
+}
+
+// reconcileStore is the subset of beads operations gt reconcile needs.
+// Allows injecting a fake store in tests without shelling out to bd.
+type reconcileStore interface {
+	ListAgentBeads() (map[string]*beads.Issue, error)
+	List(opts beads.ListOptions) ([]*beads.Issue, error)
+	UpdateAgentState(id string, state string) error
+}
+
+// newReconcileStore opens a rig's beads. Tests override this variable.
+var newReconcileStore = func(beadsPath string) reconcileStore {
+	return beads.New(beadsPath)
+}
+
+// reconcileSessionAlive reports whether an agent session is running with a
+// live agent process. An error means tmux could not be queried, not that the
+// session is dead. Tests override this variable to avoid tmux.
+var reconcileSessionAlive = func(sessionName string) (bool, error) {
+	t := tmux.NewTmux()
+	exists, err := t.HasSession(sessionName)
+	if err != nil || !exists {
+		return false, err
+	}
+	return t.IsAgentAlive(sessionName), nil
+}
+
+// agentStateFix is one agent bead correction found by planReconcile.
+type agentStateFix struct {
+	ID     string
+	From   string
+	To     beads.AgentState
+	Reason string
+}
+
+func runReconcile(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	rigNames := []string{reconcileRig}
+	if reconcileRig == "" {
+		rigNames, err = reconcileRigNames(townRoot)
+		if err != nil {
+			return err
+		}
+	}
+
+	var fixed, failed int
+	for _, rigName := range rigNames {
+		n, f, err := reconcileRigAgents(townRoot, rigName, reconcileDryRun)
+		if err != nil {
+			style.PrintWarning("%s: %v", rigName, err)
+			failed++
+			continue
+		}
+		fixed += n
+		failed += f
+	}
+
+	switch {
+	case fixed == 0 && failed == 0:
+		fmt.Printf("%s All agent beads match live sessions\n", style.Dim.Render("○"))
+	case reconcileDryRun:
+		fmt.Printf("%s %d agent bead(s) would be corrected (dry run)\n", style.Dim.Render("○"), fixed)
+	default:
+		fmt.Printf("%s Corrected %d agent bead(s)\n", style.Bold.Render("✓"), fixed)
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to reconcile %d agent bead(s) or rig(s)", failed)
+	}
+	return nil
+}
+
+// reconcileRigNames returns the sorted names of registered rigs.
+func reconcileRigNames(townRoot string) ([]string, error) {
+	rigsConfig, err := config.LoadRigsConfig(constants.MayorRigsPath(townRoot))
+	if err != nil {
+		return nil, fmt.Errorf("loading rigs: %w", err)
+	}
+	names := make([]string, 0, len(rigsConfig.Rigs))
+	for name := range rigsConfig.Rigs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// reconcileRigAgents corrects the agent beads of one rig, printing each
+// correction. Returns the number corrected (or that would be, when dryRun)
+// and the number that failed to update.
+func reconcileRigAgents(townRoot, rigName string, dryRun bool) (fixed, failed int, err error) {
+	store := newReconcileStore(filepath.Join(townRoot, rigName, "mayor", "rig"))
+
+	agents, err := store.ListAgentBeads()
+	if err != nil {
+		return 0, 0, fmt.Errorf("listing agent beads: %w", err)
+	}
+	hookedWork, err := store.List(beads.ListOptions{Status: beads.StatusHooked, Priority: -1})
+	if err != nil {
+		return 0, 0, fmt.Errorf("listing hooked work: %w", err)
+	}
+	hooked := make(map[string]bool, len(hookedWork))
+	for _, issue := range hookedWork {
+		if issue.Assignee != "" {
+			hooked[issue.Assignee] = true
+		}
+	}
+
+	for _, fix := range planReconcile(rigName, agents, hooked, reconcileSessionAlive) {
+		line := fmt.Sprintf("%s: %s → %s (%s)", fix.ID, fix.From, fix.To, fix.Reason)
+		if dryRun {
+			fmt.Printf("  %s %s\n", style.Dim.Render("○"), line)
+			fixed++
+			continue
+		}
+		if err := store.UpdateAgentState(fix.ID, string(fix.To)); err != nil {
+			style.PrintWarning("couldn't update %s: %v", fix.ID, err)
+			failed++
+			continue
+		}
+		fmt.Printf("  %s %s\n", style.Bold.Render("✓"), line)
+		fixed++
+	}
+	return fixed, failed, nil
+}
+
+// planReconcile returns the corrections for a rig's agent beads, sorted by
+// bead ID. hooked holds the assignees that have hooked work. Only agents
+// that claim to be active but have no live session are corrected; a session
+// liveness error leaves the bead alone.
+func planReconcile(rigName string, agents map[string]*beads.Issue, hooked map[string]bool, alive func(string) (bool, error)) []agentStateFix {
+	var fixes []agentStateFix
+	for id, issue := range agents {
+		if issue == nil {
+			continue
+		}
+		agentRig, role, name, ok := beads.ParseAgentBeadID(id)
+		if !ok || agentRig != rigName {
+			continue
+		}
+		sessionName, assignee := reconcileAgentIdentity(rigName, role, name)
+		if sessionName == "" {
+			continue
+		}
+
+		state := issue.AgentState
+		if fields := beads.ParseAgentFields(issue.Description); state == "" && fields != nil {
+			state = fields.AgentState
+		}
+		if !beads.AgentState(state).IsActive() {
+			continue
+		}
+		if running, err := alive(sessionName); err != nil || running {
+			continue
+		}
+
+		fix := agentStateFix{ID: id, From: state, To: beads.AgentStateIdle, Reason: "session dead"}
+		if hooked[assignee] {
+			fix.To = beads.AgentStateStuck
+			fix.Reason = "session dead with hooked work"
+		}
+		fixes = append(fixes, fix)
+	}
+	sort.Slice(fixes, func(i, j int) bool { return fixes[i].ID < fixes[j].ID })
+	return fixes
+}
+
+// reconcileAgentIdentity returns the tmux session name and work assignee
+// for a rig-level agent, or empty strings for roles gt reconcile skips.
+func reconcileAgentIdentity(rigName, role, name string) (sessionName, assignee string) {
+	prefix := session.PrefixFor(rigName)
+	switch role {
+	case constants.RoleWitness:
+		return session.WitnessSessionName(prefix), rigName + "/" + role
+	case constants.RoleRefinery:
+		return session.RefinerySessionName(prefix), rigName + "/" + role
+	case constants.RolePolecat:
+		if name == "" {
+			return "", ""
+		}
+		return session.PolecatSessionName(prefix, name), rigName + "/polecats/" + name
+	case constants.RoleCrew:
+		if name == "" {
+			return "", ""
+		}
+		return session.CrewSessionName(prefix, name), rigName + "/crew/" + name
+	default:
+		return "", ""
+	}
+}
@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+type fakeReconcileStore struct {
+	agents  map[string]*beads.Issue
+	hooked  []*beads.Issue
+	updates map[string]string
+	failOn  string
+}
+
+func (s *fakeReconcileStore) ListAgentBeads() (map[string]*beads.Issue, error) {
+	return s.agents, nil
+}
+
+func (s *fakeReconcileStore) List(opts beads.ListOptions) ([]*beads.Issue, error) {
+	if opts.Status != beads.StatusHooked {
+		return nil, nil
+	}
+	return s.hooked, nil
+}
+
+func (s *fakeReconcileStore) UpdateAgentState(id string, state string) error {
+	if id == s.failOn {
+		return errors.New("database is locked")
+	}
+	if s.updates == nil {
+		s.updates = make(map[string]string)
+	}
+	s.updates[id] = state
+	return nil
+}
+
+func TestReconcileRigAgents(t *testing.T) {
+	store := &fakeReconcileStore{
+		agents: map[string]*beads.Issue{
+			// Matching: says working, session alive.
+			"gt-gastown-polecat-nux": {ID: "gt-gastown-polecat-nux", AgentState: "working"},
+			// Mismatch: says working, session dead, nothing hooked.
+			"gt-gastown-polecat-toast": {ID: "gt-gastown-polecat-toast", AgentState: "working"},
+			// Mismatch: state only in description, session dead, work hooked.
+			"gt-gastown-polecat-slit": {ID: "gt-gastown-polecat-slit", Description: "agent_state: running"},
+			// Matching: already idle with a dead session.
+			"gt-gastown-polecat-rust": {ID: "gt-gastown-polecat-rust", AgentState: "idle"},
+			// Intentional pause is never touched.
+			"gt-gastown-polecat-furi": {ID: "gt-gastown-polecat-furi", AgentState: "awaiting-gate"},
+			// Mismatch on a singleton role.
+			"gt-gastown-witness": {ID: "gt-gastown-witness", AgentState: "running"},
+			// Another rig's agent is out of scope.
+			"gt-beads-polecat-max": {ID: "gt-beads-polecat-max", AgentState: "working"},
+		},
+		hooked: []*beads.Issue{{ID: "gt-work1", Assignee: "gastown/polecats/slit"}},
+	}
+
+	origStore, origAlive := newReconcileStore, reconcileSessionAlive
+	t.Cleanup(func() { newReconcileStore, reconcileSessionAlive = origStore, origAlive })
+	newReconcileStore = func(string) reconcileStore { return store }
+	reconcileSessionAlive = func(name string) (bool, error) {
+		return name == "gt-nux", nil
+	}
+
+	fixed, failed, err := reconcileRigAgents(t.TempDir(), "gastown", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fixed != 3 || failed != 0 {
+		t.Errorf("fixed, failed = %d, %d; want 3, 0", fixed, failed)
+	}
+	want := map[string]string{
+		"gt-gastown-polecat-toast": "idle",
+		"gt-gastown-polecat-slit":  "stuck",
+		"gt-gastown-witness":       "idle",
+	}
+	if !reflect.DeepEqual(store.updates, want) {
+		t.Errorf("updates = %v, want %v", store.updates, want)
+	}
+}
+
+func TestReconcileRigAgents_DryRunAndFailures(t *testing.T) {
+	store := &fakeReconcileStore{
+		agents: map[string]*beads.Issue{
+			"gt-gastown-polecat-toast": {ID: "gt-gastown-polecat-toast", AgentState: "working"},
+			"gt-gastown-crew-max":      {ID: "gt-gastown-crew-max", AgentState: "working"},
+		},
+		failOn: "gt-gastown-crew-max",
+	}
+
+	origStore, origAlive := newReconcileStore, reconcileSessionAlive
+	t.Cleanup(func() { newReconcileStore, reconcileSessionAlive = origStore, origAlive })
+	newReconcileStore = func(string) reconcileStore { return store }
+	reconcileSessionAlive = func(string) (bool, error) { return false, nil }
+
+	fixed, failed, err := reconcileRigAgents(t.TempDir(), "gastown", true)
+	if err != nil || fixed != 2 || failed != 0 {
+		t.Errorf("dry run = %d, %d, %v; want 2, 0, nil", fixed, failed, err)
+	}
+	if len(store.updates) != 0 {
+		t.Errorf("dry run updated beads: %v", store.updates)
+	}
+
+	fixed, failed, err = reconcileRigAgents(t.TempDir(), "gastown", false)
+	if err != nil || fixed != 1 || failed != 1 {
+		t.Errorf("apply = %d, %d, %v; want 1, 1, nil", fixed, failed, err)
+	}
+}
+
+func TestPlanReconcile_SessionCheckError(t *testing.T) {
+	agents := map[string]*beads.Issue{
+		"gt-gastown-polecat-nux": {ID: "gt-gastown-polecat-nux", AgentState: "working"},
+	}
+	fixes := planReconcile("gastown", agents, nil, func(string) (bool, error) {
+		return false, errors.New("no tmux server")
+	})
+	if len(fixes) != 0 {
+		t.Errorf("liveness error should leave beads alone, got %+v", fixes)
+	}
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var rigDrainCmd = &cobra.Command{
+	Use:   "drain <rig>...",
+	Short: "Stop assigning new work to one or more rigs",
+	Long: `Drain rigs for maintenance.
+
+Draining a rig:
+  - Blocks new work from being slung or launched to the rig
+  - Leaves the witness, refinery, and polecats running so in-flight
+    convoys can finish and merge
+  - Shows a DRAINING banner in the gt feed convoy panel
+
+Like parking, this is local wisp state. Use 'gt rig undrain' to resume
+dispatch, or 'gt rig park' once the rig is idle to stop its agents.
+
+Examples:
+  gt rig drain gastown
+  gt rig drain beads gastown`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runRigDrain,
+}
+
+var rigUndrainCmd = &cobra.Command{
+	Use:   "undrain <rig>...",
+	Short: "Resume assigning new work to one or more rigs",
+	Long: `Undrain rigs so new work can be dispatched to them again.
+
+Examples:
+  gt rig undrain gastown
+  gt rig undrain beads gastown`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runRigUndrain,
+}
+
+func init() {
+	rigCmd.AddCommand(rigDrainCmd)
+	rigCmd.AddCommand(rigUndrainCmd)
+}
+
+func runRigDrain(cmd *cobra.Command, args []string) error {
+	return setRigsDraining(args, true)
+}
+
+func runRigUndrain(cmd *cobra.Command, args []string) error {
+	return setRigsDraining(args, false)
+}
+
+// setRigsDraining toggles drain mode on each named rig, reporting every
+// failure before returning.
+func setRigsDraining(rigNames []string, draining bool) error {
+	verb := "drain"
+	if !draining {
+		verb = "undrain"
+	}
+
+	var errs []error
+	for _, rigName := range rigNames {
+		townRoot, _, err := getRig(rigName)
+		if err == nil {
+			err = rig.SetDraining(townRoot, rigName, draining)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", rigName, err))
+			continue
+		}
+		if draining {
+			fmt.Printf("%s Rig %s draining (local only)\n", style.Success.Render("✓"), rigName)
+			fmt.Printf("  No new work will be dispatched; in-flight work continues\n")
+		} else {
+			fmt.Printf("%s Rig %s undrained\n", style.Success.Render("✓"), rigName)
+			fmt.Printf("  New work can be dispatched again\n")
+		}
+	}
+
+	if len(errs) > 0 {
+		for _, err := range errs {
+			fmt.Printf("%s %v\n", style.Error.Render("✗"), err)
+		}
+		return fmt.Errorf("failed to %s %d rig(s)", verb, len(errs))
+	}
+	return nil
+}
+
+// IsRigDispatchBlocked reports whether new work must not be dispatched to a
+// rig: it is parked, docked, or draining. Returns (blocked, reason). Agent
+// start paths use IsRigParkedOrDocked instead, since a draining rig keeps
+// its agents running to finish in-flight work.
+func IsRigDispatchBlocked(townRoot, rigName string) (bool, string) {
+	if blocked, reason := IsRigParkedOrDocked(townRoot, rigName); blocked {
+		return true, reason
+	}
+	if rig.IsDraining(townRoot, rigName) {
+		return true, "draining"
+	}
+	return false, ""
+}
+
+// rigUnblockCmd returns the command that clears a dispatch block reason
+// reported by IsRigDispatchBlocked.
+func rigUnblockCmd(reason string) string {
+	switch reason {
+	case "docked":
+		return "gt rig undock"
+	case "draining":
+		return "gt rig undrain"
+	default:
+		return "gt rig unpark"
+	}
+}
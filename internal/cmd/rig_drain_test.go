@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestIsRigDispatchBlocked_Draining(t *testing.T) {
+	townRoot := t.TempDir()
+
+	if blocked, reason := IsRigDispatchBlocked(townRoot, "gastown"); blocked {
+		t.Fatalf("operational rig blocked: %s", reason)
+	}
+
+	if err := rig.SetDraining(townRoot, "gastown", true); err != nil {
+		t.Fatal(err)
+	}
+	blocked, reason := IsRigDispatchBlocked(townRoot, "gastown")
+	if !blocked || reason != "draining" {
+		t.Errorf("IsRigDispatchBlocked = (%v, %q), want (true, draining)", blocked, reason)
+	}
+	if parked, _ := IsRigParkedOrDocked(townRoot, "gastown"); parked {
+		t.Error("draining must not count as parked/docked (agents keep running)")
+	}
+
+	if err := rig.SetDraining(townRoot, "gastown", false); err != nil {
+		t.Fatal(err)
+	}
+	if blocked, reason := IsRigDispatchBlocked(townRoot, "gastown"); blocked {
+		t.Errorf("undrained rig still blocked: %s", reason)
+	}
+}
+
+func TestRigUnblockCmd(t *testing.T) {
+	for reason, want := range map[string]string{
+		"parked":   "gt rig unpark",
+		"docked":   "gt rig undock",
+		"draining": "gt rig undrain",
+	} {
+		if got := rigUnblockCmd(reason); got != want {
+			t.Errorf("rigUnblockCmd(%q) = %q, want %q", reason, got, want)
+		}
+	}
+}
@@ -88,6 +88,17 @@ var branchCheckExemptCommands = map[string]bool{
 
 // persistentPreRun runs before every command.
 func persistentPreRun(cmd *cobra.Command, args []string) error {
+	// Apply the global output level before anything prints.
+	if globalQuiet && globalVerbose {
+		return fmt.Errorf("--quiet and --verbose are mutually exclusive")
+	}
+	switch {
+	case globalQuiet:
+		style.SetVerbosity(style.VerbosityQuiet)
+	case globalVerbose:
+		style.SetVerbosity(style.VerbosityVerbose)
+	}
+
 	// Check if binary was built properly (via make build, not raw go build).
 	// Raw go build produces unsigned binaries that macOS may kill.
 	// Warning only - doesn't block execution.
@@ -242,6 +253,12 @@ func warnIfTownRootOffMain() {
 		style.Dim.Render("gt doctor --fix"))
 }
 
+// Global output level flags (see init).
+var (
+	globalQuiet   bool
+	globalVerbose bool
+)
+
 // staleBinaryWarned tracks if we've already warned about stale binary in this session.
 // We use an environment variable since the binary restarts on each command.
 var staleBinaryWarned = os.Getenv("GT_STALE_WARNED") == "1"
@@ -341,8 +358,10 @@ func init() {
 	rootCmd.SetHelpCommandGroupID(GroupDiag)
 	rootCmd.SetCompletionCommandGroupID(GroupConfig)
 
-	// Global flags can be added here
-	// rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file")
+	// Global output level, consulted by the style print helpers. Subcommands
+	// that define their own --quiet or --verbose flag shadow these.
+	rootCmd.PersistentFlags().BoolVarP(&globalQuiet, "quiet", "q", false, "Only show errors")
+	rootCmd.PersistentFlags().BoolVar(&globalVerbose, "verbose", false, "Show detailed output")
 }
 
 // buildCommandPath walks the command hierarchy to build the full command path.
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/events"
@@ -111,15 +112,11 @@ func executeSling(params SlingParams) (*SlingResult, error) {
 		BeadID: params.BeadID,
 	}
 
-	// 0. Check if rig is parked or docked before dispatching (gt-4owfd.1, gt-11y)
+	// 0. Check if rig is parked, docked, or draining before dispatching (gt-4owfd.1, gt-11y)
 	if params.RigName != "" {
-		if blocked, reason := IsRigParkedOrDocked(townRoot, params.RigName); blocked {
+		if blocked, reason := IsRigDispatchBlocked(townRoot, params.RigName); blocked {
 			result.ErrMsg = "rig " + reason
-			undoCmd := "gt rig unpark"
-			if reason == "docked" {
-				undoCmd = "gt rig undock"
-			}
-			return result, fmt.Errorf("cannot sling to %s rig %q\n%s %s", reason, params.RigName, undoCmd, params.RigName)
+			return result, fmt.Errorf("cannot sling to %s rig %q\n%s %s", reason, params.RigName, rigUnblockCmd(reason), params.RigName)
 		}
 	}
 
@@ -336,6 +333,7 @@ func executeSling(params SlingParams) (*SlingResult, error) {
 		NoMerge:          params.NoMerge,
 		Mode:             params.Mode,
 		FormulaVars:      strings.Join(allVars, "\n"),
+		HookedAt:         time.Now().UTC().Format(time.RFC3339),
 	}
 	// Use beadToHook for the update target (may differ from beadID when formula-on-bead)
 	if err := storeFieldsInBead(beadToHook, fieldUpdates); err != nil {
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/cli"
@@ -205,6 +206,7 @@ func runSlingFormula(ctx context.Context, args []string) error {
 		Vars:            append([]string(nil), slingVars...),
 		AttachedFormula: formulaName,
 		FormulaVars:     strings.Join(slingVars, "\n"),
+		HookedAt:        time.Now().UTC().Format(time.RFC3339),
 	}
 	if err := storeFieldsInBead(wispRootID, fieldUpdates); err != nil {
 		fmt.Printf("%s Could not store fields in bead: %v\n", style.Dim.Render("Warning:"), err)
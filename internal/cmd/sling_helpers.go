@@ -266,6 +266,7 @@ type beadFieldUpdates struct {
 	MergeStrategy    string // Convoy merge strategy: "direct", "mr", "local"
 	ConvoyOwned      bool   // Convoy has gt:owned label (caller-managed lifecycle)
 	FormulaVars      string // Newline-separated key=value pairs for formula template substitution
+	HookedAt         string // When the bead was hooked (RFC3339); gt done measures time_spent from it
 }
 
 // storeFieldsInBead performs a single read-modify-write to update all attachment fields
@@ -343,6 +344,9 @@ func storeFieldsInBead(beadID string, updates beadFieldUpdates) error {
 	if updates.FormulaVars != "" {
 		fields.FormulaVars = updates.FormulaVars
 	}
+	if updates.HookedAt != "" {
+		fields.HookedAt = updates.HookedAt
+	}
 
 	// Write back once
 	newDesc := beads.SetAttachmentFields(issue, fields)
@@ -180,18 +180,14 @@ func resolveTarget(target string, opts ResolveTargetOptions) (*ResolvedTarget, e
 
 	// Rig target (auto-spawn polecat)
 	if rigName, isRig := IsRigName(target); isRig {
-		// Check if rig is parked or docked before dispatching (gt-4owfd.1, gt-11y)
+		// Check if rig is parked, docked, or draining before dispatching (gt-4owfd.1, gt-11y)
 		townRoot := opts.TownRoot
 		if townRoot == "" {
 			townRoot, _ = workspace.FindFromCwd()
 		}
 		if townRoot != "" {
-			if blocked, reason := IsRigParkedOrDocked(townRoot, rigName); blocked {
-				undoCmd := "gt rig unpark"
-				if reason == "docked" {
-					undoCmd = "gt rig undock"
-				}
-				return nil, fmt.Errorf("cannot sling to %s rig %q\n%s %s", reason, rigName, undoCmd, rigName)
+			if blocked, reason := IsRigDispatchBlocked(townRoot, rigName); blocked {
+				return nil, fmt.Errorf("cannot sling to %s rig %q\n%s %s", reason, rigName, rigUnblockCmd(reason), rigName)
 			}
 		}
 
@@ -48,7 +48,10 @@ var statusCmd = &cobra.Command{
 Shows town name, registered rigs, polecats, and witness status.
 
 Use --fast to skip mail lookups for faster execution.
-Use --watch to continuously refresh status at regular intervals.`,
+Use --watch to continuously refresh status at regular intervals.
+Use --json for machine-readable output; it includes a "health" summary
+aggregating workspace doctor checks, convoy states, account rate limits,
+and orphan counts for monitoring.`,
 	RunE: runStatus,
 }
 
@@ -74,6 +77,7 @@ type TownStatus struct {
 	Agents   []AgentRuntime `json:"agents"`             // Global agents (Mayor, Deacon)
 	Rigs     []RigStatus    `json:"rigs"`
 	Summary  StatusSum      `json:"summary"`
+	Health   *TownHealth    `json:"health,omitempty"` // Aggregated health (--json only)
 }
 
 // ServiceInfo represents a background service status.
@@ -599,6 +603,7 @@ func runStatusOnce(_ *cobra.Command, _ []string) error {
 		return err
 	}
 	if statusJSON {
+		status.Health = gatherTownHealth(status.Location)
 		return outputStatusJSON(status)
 	}
 	return outputStatusText(os.Stdout, status)
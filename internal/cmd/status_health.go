@@ -0,0 +1,181 @@
+package cmd
+
+import (
+	"sort"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/quota"
+	"github.com/steveyegge/gastown/internal/tui/feed"
+)
+
+// Town health levels, from best to worst.
+const (
+	townHealthOK      = "ok"
+	townHealthWarning = "warning"
+	townHealthError   = "error"
+)
+
+// TownHealth is the machine-readable health summary included in
+// gt status --json. It aggregates snapshots from the doctor, convoy,
+// quota (rate-limit), and orphan subsystems so monitoring can poll one
+// command instead of four.
+type TownHealth struct {
+	Status    string             `json:"status"` // ok, warning, or error (worst of the subsystems)
+	Doctor    *DoctorHealth      `json:"doctor,omitempty"`
+	Convoys   *feed.ConvoyHealth `json:"convoys,omitempty"`
+	RateLimit *RateLimitHealth   `json:"rate_limit,omitempty"`
+	Orphans   *OrphanHealth      `json:"orphans,omitempty"`
+	Errors    []string           `json:"errors,omitempty"` // Subsystems that could not be read
+}
+
+// DoctorHealth summarizes the workspace doctor checks.
+type DoctorHealth struct {
+	Total    int      `json:"total"`
+	OK       int      `json:"ok"`
+	Warnings int      `json:"warnings"`
+	Errors   int      `json:"errors"`
+	Failing  []string `json:"failing,omitempty"` // Names of checks that warned or errored
+}
+
+// RateLimitHealth summarizes account quota state from mayor/quota.json.
+type RateLimitHealth struct {
+	Accounts  int      `json:"accounts"`
+	Available int      `json:"available"`
+	Limited   int      `json:"limited"`
+	Cooldown  int      `json:"cooldown"`
+	Blocked   []string `json:"blocked,omitempty"` // Handles that are limited or cooling down
+}
+
+// OrphanHealth counts leftovers that gt orphans / gt health would report.
+type OrphanHealth struct {
+	Databases int `json:"databases"`
+	Processes int `json:"processes"`
+}
+
+// townHealthSnapshot holds the raw subsystem data buildTownHealth reduces.
+// Nil fields mean the subsystem was not read; Errors records why.
+type townHealthSnapshot struct {
+	Doctor          *doctor.Report
+	Convoys         *feed.ConvoyState
+	Quota           *config.QuotaState
+	OrphanDBs       []OrphanDB
+	OrphanProcesses []OrphanProcess
+	Errors          []string
+}
+
+// gatherTownHealth collects subsystem snapshots for townRoot. Only the
+// workspace-level doctor checks run here, to keep gt status fast; run
+// gt doctor for the full set. Failures are recorded in the snapshot rather
+// than returned, so one unreadable subsystem does not hide the others.
+func gatherTownHealth(townRoot string) *TownHealth {
+	var snap townHealthSnapshot
+
+	d := doctor.NewDoctor()
+	d.RegisterAll(doctor.WorkspaceChecks()...)
+	snap.Doctor = d.Run(&doctor.CheckContext{TownRoot: townRoot})
+
+	if convoys, err := feed.FetchConvoys(townRoot, 0); err != nil {
+		snap.Errors = append(snap.Errors, "convoys: "+err.Error())
+	} else {
+		snap.Convoys = convoys
+	}
+
+	if state, err := quota.NewManager(townRoot).Load(); err != nil {
+		snap.Errors = append(snap.Errors, "rate_limit: "+err.Error())
+	} else {
+		snap.Quota = state
+	}
+
+	snap.OrphanDBs = checkOrphanDBs(townRoot)
+	if procs, err := findOrphanProcesses(); err != nil {
+		snap.Errors = append(snap.Errors, "orphan processes: "+err.Error())
+	} else {
+		snap.OrphanProcesses = procs
+	}
+
+	return buildTownHealth(snap)
+}
+
+// buildTownHealth reduces subsystem snapshots to a TownHealth. The overall
+// status is error when a doctor check errors or every account is
+// rate-limited, and warning for doctor warnings, stuck convoys, any
+// rate-limited account, orphans, or an unreadable subsystem.
+func buildTownHealth(snap townHealthSnapshot) *TownHealth {
+	h := &TownHealth{Status: townHealthOK, Errors: snap.Errors}
+	worsen := func(level string) {
+		if level == townHealthError || (level == townHealthWarning && h.Status == townHealthOK) {
+			h.Status = level
+		}
+	}
+	// FetchConvoys reports a missing or broken bd through ToolingError
+	// rather than an error; its empty sections mean "unknown", not healthy.
+	if snap.Convoys != nil && snap.Convoys.ToolingError != "" {
+		h.Errors = append(h.Errors, "convoys: "+snap.Convoys.ToolingError)
+		snap.Convoys = nil
+	}
+	if len(h.Errors) > 0 {
+		worsen(townHealthWarning)
+	}
+
+	if snap.Doctor != nil {
+		dh := &DoctorHealth{
+			Total:    snap.Doctor.Summary.Total,
+			OK:       snap.Doctor.Summary.OK,
+			Warnings: snap.Doctor.Summary.Warnings,
+			Errors:   snap.Doctor.Summary.Errors,
+		}
+		for _, c := range snap.Doctor.Checks {
+			if c.Status != doctor.StatusOK {
+				dh.Failing = append(dh.Failing, c.Name)
+			}
+		}
+		h.Doctor = dh
+		if dh.Errors > 0 {
+			worsen(townHealthError)
+		} else if dh.Warnings > 0 {
+			worsen(townHealthWarning)
+		}
+	}
+
+	if snap.Convoys != nil {
+		ch := snap.Convoys.Health()
+		h.Convoys = &ch
+		if ch.Stuck > 0 {
+			worsen(townHealthWarning)
+		}
+	}
+
+	if snap.Quota != nil {
+		rh := &RateLimitHealth{Accounts: len(snap.Quota.Accounts)}
+		for handle, acct := range snap.Quota.Accounts {
+			switch acct.Status {
+			case config.QuotaStatusLimited:
+				rh.Limited++
+				rh.Blocked = append(rh.Blocked, handle)
+			case config.QuotaStatusCooldown:
+				rh.Cooldown++
+				rh.Blocked = append(rh.Blocked, handle)
+			default:
+				rh.Available++
+			}
+		}
+		sort.Strings(rh.Blocked)
+		h.RateLimit = rh
+		if rh.Accounts > 0 && rh.Available == 0 {
+			worsen(townHealthError)
+		} else if len(rh.Blocked) > 0 {
+			worsen(townHealthWarning)
+		}
+	}
+
+	h.Orphans = &OrphanHealth{
+		Databases: len(snap.OrphanDBs),
+		Processes: len(snap.OrphanProcesses),
+	}
+	if h.Orphans.Databases > 0 || h.Orphans.Processes > 0 {
+		worsen(townHealthWarning)
+	}
+
+	return h
+}
@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/tui/feed"
+)
+
+func fakeDoctorReport(statuses map[string]doctor.CheckStatus) *doctor.Report {
+	r := doctor.NewReport()
+	for _, name := range []string{"town-config-exists", "rigs-registry-valid", "mayor-exists"} {
+		status, ok := statuses[name]
+		if !ok {
+			status = doctor.StatusOK
+		}
+		r.Add(&doctor.CheckResult{Name: name, Status: status})
+	}
+	return r
+}
+
+func TestBuildTownHealth_AllHealthy(t *testing.T) {
+	h := buildTownHealth(townHealthSnapshot{
+		Doctor: fakeDoctorReport(nil),
+		Convoys: &feed.ConvoyState{InProgress: []feed.Convoy{
+			{ID: "hq-cv-1", WorkState: convoy.WorkStateActive},
+			{ID: "hq-cv-2", WorkState: convoy.WorkStateWaiting},
+		}},
+		Quota: &config.QuotaState{Accounts: map[string]config.AccountQuotaState{
+			"alice": {Status: config.QuotaStatusAvailable},
+		}},
+	})
+
+	if h.Status != townHealthOK {
+		t.Errorf("Status = %q, want ok", h.Status)
+	}
+	if h.Doctor == nil || h.Doctor.Total != 3 || h.Doctor.OK != 3 || len(h.Doctor.Failing) != 0 {
+		t.Errorf("Doctor = %+v, want 3 ok checks", h.Doctor)
+	}
+	if h.Convoys == nil || h.Convoys.Active != 1 || h.Convoys.Waiting != 1 {
+		t.Errorf("Convoys = %+v", h.Convoys)
+	}
+	if h.RateLimit == nil || h.RateLimit.Accounts != 1 || h.RateLimit.Available != 1 {
+		t.Errorf("RateLimit = %+v", h.RateLimit)
+	}
+	if h.Orphans == nil || h.Orphans.Databases != 0 || h.Orphans.Processes != 0 {
+		t.Errorf("Orphans = %+v", h.Orphans)
+	}
+}
+
+func TestBuildTownHealth_AggregatesProblems(t *testing.T) {
+	h := buildTownHealth(townHealthSnapshot{
+		Doctor: fakeDoctorReport(map[string]doctor.CheckStatus{"mayor-exists": doctor.StatusWarning}),
+		Convoys: &feed.ConvoyState{InProgress: []feed.Convoy{
+			{ID: "hq-cv-1", WorkState: convoy.WorkStateStuck},
+			{ID: "hq-cv-2", WorkState: convoy.WorkStateIdle},
+		}},
+		Quota: &config.QuotaState{Accounts: map[string]config.AccountQuotaState{
+			"alice": {Status: config.QuotaStatusAvailable},
+			"bob":   {Status: config.QuotaStatusLimited},
+			"carol": {Status: config.QuotaStatusCooldown},
+		}},
+		OrphanDBs:       []OrphanDB{{Name: "old_rig"}},
+		OrphanProcesses: []OrphanProcess{{PID: 1234}, {PID: 5678}},
+	})
+
+	if h.Status != townHealthWarning {
+		t.Errorf("Status = %q, want warning", h.Status)
+	}
+	if !reflect.DeepEqual(h.Doctor.Failing, []string{"mayor-exists"}) {
+		t.Errorf("Doctor.Failing = %v", h.Doctor.Failing)
+	}
+	if h.Convoys.Stuck != 1 || h.Convoys.Idle != 1 {
+		t.Errorf("Convoys = %+v", h.Convoys)
+	}
+	want := &RateLimitHealth{Accounts: 3, Available: 1, Limited: 1, Cooldown: 1, Blocked: []string{"bob", "carol"}}
+	if !reflect.DeepEqual(h.RateLimit, want) {
+		t.Errorf("RateLimit = %+v, want %+v", h.RateLimit, want)
+	}
+	if h.Orphans.Databases != 1 || h.Orphans.Processes != 2 {
+		t.Errorf("Orphans = %+v", h.Orphans)
+	}
+}
+
+func TestBuildTownHealth_ErrorLevels(t *testing.T) {
+	tests := []struct {
+		name string
+		snap townHealthSnapshot
+		want string
+	}{
+		{
+			name: "doctor error",
+			snap: townHealthSnapshot{Doctor: fakeDoctorReport(map[string]doctor.CheckStatus{"town-config-exists": doctor.StatusError})},
+			want: townHealthError,
+		},
+		{
+			name: "every account rate-limited",
+			snap: townHealthSnapshot{Quota: &config.QuotaState{Accounts: map[string]config.AccountQuotaState{
+				"alice": {Status: config.QuotaStatusLimited},
+			}}},
+			want: townHealthError,
+		},
+		{
+			name: "no accounts configured",
+			snap: townHealthSnapshot{Quota: &config.QuotaState{}},
+			want: townHealthOK,
+		},
+		{
+			name: "unreadable subsystem",
+			snap: townHealthSnapshot{Errors: []string{"convoys: bd not found"}},
+			want: townHealthWarning,
+		},
+		{
+			name: "convoy tooling unavailable",
+			snap: townHealthSnapshot{Convoys: &feed.ConvoyState{ToolingError: "bd not found on PATH"}},
+			want: townHealthWarning,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildTownHealth(tt.snap).Status; got != tt.want {
+				t.Errorf("Status = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildTownHealth_ConvoyToolingErrorIsSubsystemError(t *testing.T) {
+	h := buildTownHealth(townHealthSnapshot{Convoys: &feed.ConvoyState{ToolingError: "bd not found on PATH"}})
+	if h.Convoys != nil {
+		t.Errorf("Convoys = %+v, want nil when bd is unavailable", h.Convoys)
+	}
+	if len(h.Errors) != 1 || h.Errors[0] != "convoys: bd not found on PATH" {
+		t.Errorf("Errors = %v, want the convoy tooling error", h.Errors)
+	}
+}
+
+func TestBuildTownHealth_OmitsUnreadSubsystems(t *testing.T) {
+	h := buildTownHealth(townHealthSnapshot{Errors: []string{"rate_limit: parsing quota state: bad json"}})
+	if h.Doctor != nil || h.Convoys != nil || h.RateLimit != nil {
+		t.Errorf("unread subsystems should be nil: %+v", h)
+	}
+
+	data, err := json.Marshal(TownStatus{Health: h})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded struct {
+		Health map[string]json.RawMessage `json:"health"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"status", "orphans", "errors"} {
+		if _, ok := decoded.Health[key]; !ok {
+			t.Errorf("health JSON missing %q: %s", key, data)
+		}
+	}
+	for _, key := range []string{"doctor", "convoys", "rate_limit"} {
+		if _, ok := decoded.Health[key]; ok {
+			t.Errorf("health JSON should omit unread %q: %s", key, data)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Worktree prune command flags
+var (
+	worktreePruneRig       string
+	worktreePruneDryRun    bool
+	worktreePruneThreshold int
+)
+
+var worktreePruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove stale polecat worktrees",
+	Long: `Remove polecat worktrees that are no longer in use.
+
+A polecat worktree is a prune candidate when it has no active session
+(its work has merged or been abandoned) and it is not intentionally
+paused, using the same staleness rules as 'gt polecat stale'.
+
+Each candidate then goes through the same safety checks as
+'gt polecat nuke': worktrees with uncommitted changes, stashes,
+unpushed commits, work still on the hook, or an open MR are skipped
+and reported, never removed.
+
+Without --rig, every rig in the town is pruned. Branches are left in
+place; use 'gt polecat gc' to clean them up.
+
+Examples:
+  gt worktree prune --dry-run        # Show what would be removed
+  gt worktree prune --rig gastown    # Prune one rig
+  gt worktree prune                  # Prune all rigs`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runWorktreePrune,
+}
+
+func init() {
+	worktreePruneCmd.Flags().StringVar(&worktreePruneRig, "rig", "", "Only prune worktrees in this rig")
+	worktreePruneCmd.Flags().BoolVarP(&worktreePruneDryRun, "dry-run", "n", false, "Show what would be removed without removing")
+	worktreePruneCmd.Flags().IntVar(&worktreePruneThreshold, "threshold", 20, "Commits behind main to consider stale")
+	worktreeCmd.AddCommand(worktreePruneCmd)
+}
+
+// pruneCandidate is a stale polecat worktree selected for pruning.
+type pruneCandidate struct {
+	target polecatTarget
+	reason string // why the worktree is stale
+}
+
+// findPruneCandidates returns a rig's stale polecat worktrees.
+// Tests override this variable to avoid git and tmux.
+var findPruneCandidates = func(rigName string, threshold int) ([]pruneCandidate, error) {
+	mgr, r, err := getPolecatManager(rigName)
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := mgr.DetectStalePolecats(threshold)
+	if err != nil {
+		return nil, fmt.Errorf("detecting stale polecats in %s: %w", rigName, err)
+	}
+
+	var candidates []pruneCandidate
+	for _, info := range infos {
+		if !info.IsStale {
+			continue
+		}
+		candidates = append(candidates, pruneCandidate{
+			target: polecatTarget{rigName: rigName, polecatName: info.Name, mgr: mgr, r: r},
+			reason: info.Reason,
+		})
+	}
+	return candidates, nil
+}
+
+// pruneSafetyCheck reports whether a worktree is safe to remove.
+// Tests override this variable to avoid bd and git.
+var pruneSafetyCheck = checkPolecatSafety
+
+// removePrunedWorktree removes a polecat worktree without forcing, so the
+// manager's own cleanup_status and open-MR guards still apply.
+// Tests override this variable to avoid touching the filesystem.
+var removePrunedWorktree = func(t polecatTarget) error {
+	return t.mgr.Remove(t.polecatName, false)
+}
+
+// worktreePruneResult records what gt worktree prune did.
+type worktreePruneResult struct {
+	Pruned  []string
+	Skipped []string
+	Failed  []string
+}
+
+func runWorktreePrune(cmd *cobra.Command, args []string) error {
+	var rigNames []string
+	if worktreePruneRig != "" {
+		rigNames = []string{worktreePruneRig}
+	} else {
+		rigs, err := getAllRigs()
+		if err != nil {
+			return err
+		}
+		for _, r := range rigs {
+			rigNames = append(rigNames, r.Name)
+		}
+	}
+
+	var candidates []pruneCandidate
+	for _, rigName := range rigNames {
+		found, err := findPruneCandidates(rigName, worktreePruneThreshold)
+		if err != nil {
+			return err
+		}
+		candidates = append(candidates, found...)
+	}
+
+	if len(candidates) == 0 {
+		fmt.Printf("%s No stale polecat worktrees\n", style.Dim.Render("○"))
+		return nil
+	}
+
+	result := pruneWorktrees(os.Stdout, candidates, worktreePruneDryRun)
+	if len(result.Failed) > 0 {
+		return fmt.Errorf("%d worktree(s) could not be removed", len(result.Failed))
+	}
+	return nil
+}
+
+// pruneWorktrees removes each candidate that passes the safety checks and
+// writes a line per candidate to w. In dry-run mode nothing is removed.
+func pruneWorktrees(w io.Writer, candidates []pruneCandidate, dryRun bool) worktreePruneResult {
+	var result worktreePruneResult
+
+	for _, c := range candidates {
+		addr := c.target.rigName + "/" + c.target.polecatName
+
+		if check := pruneSafetyCheck(c.target); check != nil && check.Blocked {
+			result.Skipped = append(result.Skipped, addr)
+			fmt.Fprintf(w, "  %s %s: skipped (%s)\n", style.Dim.Render("○"), addr, strings.Join(check.Reasons, ", "))
+			continue
+		}
+
+		if dryRun {
+			result.Pruned = append(result.Pruned, addr)
+			fmt.Fprintf(w, "  Would remove: %s %s\n", addr, style.Dim.Render("("+c.reason+")"))
+			continue
+		}
+
+		if err := removePrunedWorktree(c.target); err != nil {
+			result.Failed = append(result.Failed, addr)
+			fmt.Fprintf(w, "  %s %s: %v\n", style.ErrorPrefix, addr, err)
+			continue
+		}
+		result.Pruned = append(result.Pruned, addr)
+		fmt.Fprintf(w, "  %s removed %s %s\n", style.Bold.Render("✓"), addr, style.Dim.Render("("+c.reason+")"))
+	}
+
+	fmt.Fprintln(w)
+	verb := "Removed"
+	if dryRun {
+		verb = "Would remove"
+	}
+	summary := fmt.Sprintf("%s %d worktree(s), skipped %d", verb, len(result.Pruned), len(result.Skipped))
+	if len(result.Failed) > 0 {
+		summary += fmt.Sprintf(", %d failed", len(result.Failed))
+	}
+	fmt.Fprintln(w, summary)
+
+	return result
+}
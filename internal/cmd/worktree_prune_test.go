@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// stubWorktreePrune replaces the safety check and removal hooks. Polecats
+// named in blocked fail the safety check; polecats named in failing fail
+// removal. It returns the polecats whose removal was attempted.
+func stubWorktreePrune(t *testing.T, blocked, failing []string) *[]string {
+	t.Helper()
+	origCheck, origRemove := pruneSafetyCheck, removePrunedWorktree
+	t.Cleanup(func() {
+		pruneSafetyCheck, removePrunedWorktree = origCheck, origRemove
+	})
+
+	pruneSafetyCheck = func(target polecatTarget) *SafetyCheckResult {
+		result := &SafetyCheckResult{Polecat: target.rigName + "/" + target.polecatName}
+		if slices.Contains(blocked, target.polecatName) {
+			result.Reasons = []string{"has unpushed commits"}
+			result.Blocked = true
+		}
+		return result
+	}
+
+	var removed []string
+	removePrunedWorktree = func(target polecatTarget) error {
+		removed = append(removed, target.polecatName)
+		if slices.Contains(failing, target.polecatName) {
+			return errors.New("shell is in worktree")
+		}
+		return nil
+	}
+	return &removed
+}
+
+func pruneCandidates(names ...string) []pruneCandidate {
+	var candidates []pruneCandidate
+	for _, name := range names {
+		candidates = append(candidates, pruneCandidate{
+			target: polecatTarget{rigName: "gastown", polecatName: name},
+			reason: "no active session",
+		})
+	}
+	return candidates
+}
+
+func TestPruneWorktrees_DryRunRemovesNothing(t *testing.T) {
+	removed := stubWorktreePrune(t, []string{"dirty"}, nil)
+
+	var out bytes.Buffer
+	result := pruneWorktrees(&out, pruneCandidates("toast", "dirty"), true)
+
+	if len(*removed) != 0 {
+		t.Errorf("dry run removed %v, want nothing", *removed)
+	}
+	if !slices.Equal(result.Pruned, []string{"gastown/toast"}) {
+		t.Errorf("Pruned = %v, want [gastown/toast]", result.Pruned)
+	}
+	if !slices.Equal(result.Skipped, []string{"gastown/dirty"}) {
+		t.Errorf("Skipped = %v, want [gastown/dirty]", result.Skipped)
+	}
+	if !strings.Contains(out.String(), "Would remove: gastown/toast") {
+		t.Errorf("output missing dry-run line:\n%s", out.String())
+	}
+}
+
+func TestPruneWorktrees_SkipsUnsafeWorktrees(t *testing.T) {
+	removed := stubWorktreePrune(t, []string{"dirty"}, nil)
+
+	var out bytes.Buffer
+	result := pruneWorktrees(&out, pruneCandidates("toast", "dirty", "nux"), false)
+
+	if !slices.Equal(*removed, []string{"toast", "nux"}) {
+		t.Errorf("removed %v, want [toast nux]", *removed)
+	}
+	if !slices.Equal(result.Pruned, []string{"gastown/toast", "gastown/nux"}) {
+		t.Errorf("Pruned = %v, want [gastown/toast gastown/nux]", result.Pruned)
+	}
+	if !slices.Equal(result.Skipped, []string{"gastown/dirty"}) {
+		t.Errorf("Skipped = %v, want [gastown/dirty]", result.Skipped)
+	}
+	if !strings.Contains(out.String(), "gastown/dirty: skipped (has unpushed commits)") {
+		t.Errorf("output missing skip reason:\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), "Removed 2 worktree(s), skipped 1") {
+		t.Errorf("output missing summary:\n%s", out.String())
+	}
+}
+
+func TestPruneWorktrees_ReportsRemovalFailures(t *testing.T) {
+	stubWorktreePrune(t, nil, []string{"toast"})
+
+	var out bytes.Buffer
+	result := pruneWorktrees(&out, pruneCandidates("toast", "nux"), false)
+
+	if !slices.Equal(result.Failed, []string{"gastown/toast"}) {
+		t.Errorf("Failed = %v, want [gastown/toast]", result.Failed)
+	}
+	if !slices.Equal(result.Pruned, []string{"gastown/nux"}) {
+		t.Errorf("Pruned = %v, want [gastown/nux]", result.Pruned)
+	}
+	if !strings.Contains(out.String(), "1 failed") {
+		t.Errorf("output missing failure count:\n%s", out.String())
+	}
+}
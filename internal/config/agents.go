@@ -582,34 +582,54 @@ func RuntimeConfigFromPreset(preset AgentPreset) *RuntimeConfig {
 	return normalizeRuntimeConfig(rc)
 }
 
-// BuildResumeCommand builds a command to resume an agent session.
-// Returns the full command string including any YOLO/autonomous flags.
-// If sessionID is empty or the agent doesn't support resume, returns empty string.
-func BuildResumeCommand(agentName, sessionID string) string {
+// BuildResumeArgs builds the argv to resume an agent session: the agent
+// command followed by its arguments, including any YOLO/autonomous flags.
+// The session ID is passed as a single argument, so callers can exec the
+// result directly without a shell.
+// If sessionID is empty or the agent doesn't support resume, returns nil.
+func BuildResumeArgs(agentName, sessionID string) []string {
 	if sessionID == "" {
-		return ""
+		return nil
 	}
 
 	info := GetAgentPresetByName(agentName)
 	if info == nil || info.ResumeFlag == "" {
-		return ""
+		return nil
 	}
 
-	// Build base command with args
-	args := append([]string(nil), info.Args...)
+	argv := []string{info.Command}
 
 	// Add resume based on style
 	switch info.ResumeStyle {
 	case "subcommand":
-		// e.g., "codex resume <session_id> --dangerously-bypass-approvals-and-sandbox"
-		return info.Command + " " + info.ResumeFlag + " " + sessionID + " " + strings.Join(args, " ")
+		// e.g., codex resume <session_id> --dangerously-bypass-approvals-and-sandbox
+		argv = append(argv, info.ResumeFlag, sessionID)
+		argv = append(argv, info.Args...)
 	case "flag":
 		fallthrough
 	default:
-		// e.g., "claude --dangerously-skip-permissions --resume <session_id>"
-		args = append(args, info.ResumeFlag, sessionID)
-		return info.Command + " " + strings.Join(args, " ")
+		// e.g., claude --dangerously-skip-permissions --resume <session_id>
+		argv = append(argv, info.Args...)
+		argv = append(argv, info.ResumeFlag, sessionID)
+	}
+	return argv
+}
+
+// BuildResumeCommand builds a command to resume an agent session.
+// Returns the full command string including any YOLO/autonomous flags, with
+// each argument shell-quoted so session IDs containing spaces or shell
+// metacharacters are passed through intact.
+// If sessionID is empty or the agent doesn't support resume, returns empty string.
+func BuildResumeCommand(agentName, sessionID string) string {
+	argv := BuildResumeArgs(agentName, sessionID)
+	if argv == nil {
+		return ""
+	}
+	quoted := make([]string, len(argv))
+	for i, arg := range argv {
+		quoted[i] = ShellQuote(arg)
 	}
+	return strings.Join(quoted, " ")
 }
 
 // SupportsSessionResume checks if an agent supports session resumption.
@@ -3,7 +3,10 @@ package config
 import (
 	"encoding/json"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"reflect"
+	"runtime"
 	"strings"
 	"testing"
 )
@@ -504,6 +507,13 @@ func TestBuildResumeCommand(t *testing.T) {
 			wantEmpty: false,
 			contains:  []string{"codex", "resume", "codex-sess-789", "--dangerously-bypass-approvals-and-sandbox"},
 		},
+		{
+			name:      "cursor resumes chat ID",
+			agentName: "cursor",
+			sessionID: "chat-7f3a",
+			wantEmpty: false,
+			contains:  []string{"cursor-agent", "-f", "--resume", "chat-7f3a"},
+		},
 		{
 			name:      "empty session ID",
 			agentName: "claude",
@@ -545,6 +555,81 @@ func TestBuildResumeCommand(t *testing.T) {
 	}
 }
 
+func TestBuildResumeArgs(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		agentName string
+		sessionID string
+		want      []string
+	}{
+		{
+			name:      "claude flag style",
+			agentName: "claude",
+			sessionID: "session-123",
+			want:      []string{"claude", "--dangerously-skip-permissions", "--resume", "session-123"},
+		},
+		{
+			name:      "codex subcommand style",
+			agentName: "codex",
+			sessionID: "codex-sess-789",
+			want:      []string{"codex", "resume", "codex-sess-789", "--dangerously-bypass-approvals-and-sandbox"},
+		},
+		{
+			// cursor-agent takes the chat ID as the --resume value; -f keeps
+			// the resumed chat in force mode like a fresh start.
+			name:      "cursor chat ID",
+			agentName: "cursor",
+			sessionID: "chat-7f3a",
+			want:      []string{"cursor-agent", "-f", "--resume", "chat-7f3a"},
+		},
+		{
+			name:      "session ID with spaces stays one argument",
+			agentName: "claude",
+			sessionID: "my session id",
+			want:      []string{"claude", "--dangerously-skip-permissions", "--resume", "my session id"},
+		},
+		{
+			name:      "session ID with quotes and metacharacters",
+			agentName: "claude",
+			sessionID: `it's "x"; rm -rf $HOME`,
+			want:      []string{"claude", "--dangerously-skip-permissions", "--resume", `it's "x"; rm -rf $HOME`},
+		},
+		{name: "empty session", agentName: "claude", sessionID: "", want: nil},
+		{name: "unknown agent", agentName: "unknown", sessionID: "x", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildResumeArgs(tt.agentName, tt.sessionID)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BuildResumeArgs(%s, %q) = %q, want %q", tt.agentName, tt.sessionID, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestBuildResumeCommand_QuotesSessionID verifies the string form survives a
+// shell round-trip with hostile session IDs.
+func TestBuildResumeCommand_QuotesSessionID(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+	for _, sessionID := range []string{"my session id", `it's "quoted"`, "a;b|c$(d)`e`"} {
+		cmd := BuildResumeCommand("claude", sessionID)
+		// Replace the agent binary with printf to observe how sh splits the args.
+		script := "printf '%s\\n' " + cmd[strings.Index(cmd, " ")+1:]
+		out, err := exec.Command("sh", "-c", script).Output()
+		if err != nil {
+			t.Fatalf("sh -c %q: %v", script, err)
+		}
+		lines := strings.Split(strings.TrimSuffix(string(out), "\n"), "\n")
+		if len(lines) != 3 || lines[2] != sessionID {
+			t.Errorf("BuildResumeCommand(%q) = %q; shell parsed args %q", sessionID, cmd, lines)
+		}
+	}
+}
+
 func TestSupportsSessionResume(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -764,6 +764,17 @@ func (c *AccountsConfig) GetAccount(handle string) *Account {
 	return nil
 }
 
+// ProviderFor returns the provider of the account with the given handle, so
+// callers that only know the active handle (GT_ACCOUNT, quota state) can
+// infer the provider. The second value is false for unknown handles.
+func (c *AccountsConfig) ProviderFor(handle string) (string, bool) {
+	acct, ok := c.Accounts[handle]
+	if !ok {
+		return "", false
+	}
+	return acct.ProviderName(), true
+}
+
 // GetDefaultAccount returns the default account, or nil if not set.
 func (c *AccountsConfig) GetDefaultAccount() *Account {
 	if c.Default == "" {
@@ -606,6 +606,59 @@ func TestAccountsConfigValidation(t *testing.T) {
 	}
 }
 
+func TestAccountsConfigProviderFor(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "mayor", "accounts.json")
+	data := `{
+  "version": 1,
+  "accounts": {
+    "acctA": {"email": "a@example.com", "config_dir": "~/.claude-accounts/acctA", "provider": "bedrock"},
+    "acctB": {"email": "b@example.com", "config_dir": "~/.claude-accounts/acctB"}
+  },
+  "default": "acctA"
+}`
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadAccountsConfig(path)
+	if err != nil {
+		t.Fatalf("LoadAccountsConfig: %v", err)
+	}
+
+	tests := []struct {
+		handle string
+		want   string
+		wantOK bool
+	}{
+		{handle: "acctA", want: "bedrock", wantOK: true},
+		{handle: "acctB", want: DefaultAccountProvider, wantOK: true},
+		{handle: "unknown", want: "", wantOK: false},
+	}
+	for _, tt := range tests {
+		got, ok := cfg.ProviderFor(tt.handle)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("ProviderFor(%q) = %q, %v; want %q, %v", tt.handle, got, ok, tt.want, tt.wantOK)
+		}
+	}
+
+	// Provider survives a save/load round trip and stays omitted when unset.
+	if err := SaveAccountsConfig(path, cfg); err != nil {
+		t.Fatalf("SaveAccountsConfig: %v", err)
+	}
+	saved, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(string(saved), `"provider"`); n != 1 {
+		t.Errorf("saved config has %d provider keys, want 1:\n%s", n, saved)
+	}
+}
+
 func TestLoadAccountsConfigNotFound(t *testing.T) {
 	t.Parallel()
 	_, err := LoadAccountsConfig("/nonexistent/path.json")
@@ -68,6 +68,7 @@ const (
 	DefaultRedispatchCooldown              = 5 * time.Minute
 	DefaultMaxFeedsPerCycle                = 3
 	DefaultFeedCooldown                    = 10 * time.Minute
+	DefaultStuckConvoyNudgeWindow          = 15 * time.Minute
 )
 
 // Polecat defaults.
@@ -498,6 +499,14 @@ func (d *DeaconThresholds) FeedCooldownD() time.Duration {
 	return DefaultFeedCooldown
 }
 
+// StuckConvoyNudgeWindowD returns the configured or default stuck-convoy nudge window.
+func (d *DeaconThresholds) StuckConvoyNudgeWindowD() time.Duration {
+	if d != nil {
+		return ParseDurationOrDefault(d.StuckConvoyNudgeWindow, DefaultStuckConvoyNudgeWindow)
+	}
+	return DefaultStuckConvoyNudgeWindow
+}
+
 // --- Polecat accessors ---
 
 // GetPolecatConfig returns the polecat thresholds, never nil.
@@ -368,6 +368,10 @@ type DeaconThresholds struct {
 
 	// FeedCooldown is min time between feeding same convoy (default "10m").
 	FeedCooldown string `json:"feed_cooldown,omitempty"`
+
+	// StuckConvoyNudgeWindow is how long a stuck convoy may stay stuck after
+	// its workers are nudged before it is escalated (default "15m").
+	StuckConvoyNudgeWindow string `json:"stuck_convoy_nudge_window,omitempty"`
 }
 
 // PolecatThresholds configures polecat session and retry thresholds.
@@ -580,6 +584,12 @@ type RigEntry struct {
 type BeadsConfig struct {
 	Repo   string `json:"repo"`   // "local" | path | git-url
 	Prefix string `json:"prefix"` // issue prefix
+
+	// IssueURL is a web link template for the rig's issues, for rigs whose
+	// beads are mirrored to a web tracker. {id} expands to the issue ID and
+	// {repo} to the web URL of the rig's git_url, e.g.
+	// "{repo}/issues?q={id}". Empty means issues have no web page.
+	IssueURL string `json:"issue_url,omitempty"`
 }
 
 // CurrentTownVersion is the current schema version for TownConfig.
@@ -1190,6 +1200,19 @@ type MergeQueueConfig struct {
 	// Nil defaults to false (manual landing required).
 	IntegrationBranchAutoLand *bool `json:"integration_branch_auto_land,omitempty"`
 
+	// MRTitleTemplate is a Go text/template for the title of merge-request
+	// beads created by gt done. Variables: {{.Issue}}, {{.Branch}},
+	// {{.Target}}, {{.Worker}}, {{.Rig}}.
+	// Default: "Merge: {{.Issue}}"
+	MRTitleTemplate string `json:"mr_title_template,omitempty"`
+
+	// MRDescriptionTemplate is a Go text/template rendered at the end of
+	// merge-request bead descriptions, with the same variables as
+	// MRTitleTemplate. The branch/target/source_issue fields the Refinery
+	// parses always come first; field-like lines in the summary are dropped.
+	// Default: "" (fields only)
+	MRDescriptionTemplate string `json:"mr_description_template,omitempty"`
+
 	// OnConflict specifies conflict resolution strategy: "assign_back" or "auto_rebase".
 	OnConflict string `json:"on_conflict"`
 
@@ -1333,6 +1356,11 @@ type AccountsConfig struct {
 	Version  int                `json:"version"`  // schema version
 	Accounts map[string]Account `json:"accounts"` // handle -> account details
 	Default  string             `json:"default"`  // default account handle
+
+	// RateLimitPatterns are extra regexes (matched case-insensitively) that
+	// indicate a rate-limited session, appended to the built-in patterns so
+	// new provider wording can be recognized without a rebuild.
+	RateLimitPatterns []string `json:"rate_limit_patterns,omitempty"`
 }
 
 // Account represents a single Claude Code account.
@@ -1340,11 +1368,25 @@ type Account struct {
 	Email       string `json:"email"`                 // account email
 	Description string `json:"description,omitempty"` // human description
 	ConfigDir   string `json:"config_dir"`            // path to CLAUDE_CONFIG_DIR
+	Provider    string `json:"provider,omitempty"`    // API provider billing this account (default: anthropic)
 }
 
 // CurrentAccountsVersion is the current schema version for AccountsConfig.
 const CurrentAccountsVersion = 1
 
+// DefaultAccountProvider is the provider assumed for accounts that don't set
+// one. Accounts are Claude Code logins, so this is almost always right.
+const DefaultAccountProvider = "anthropic"
+
+// ProviderName returns the account's provider, or DefaultAccountProvider when
+// none is set.
+func (a Account) ProviderName() string {
+	if a.Provider == "" {
+		return DefaultAccountProvider
+	}
+	return a.Provider
+}
+
 // DefaultAccountsConfigDir returns the default base directory for account configs.
 func DefaultAccountsConfigDir() (string, error) {
 	home, err := os.UserHomeDir()
@@ -413,6 +413,20 @@ var DefaultRateLimitPatterns = []string{
 	`OAuth token has expired`,                        // Token expired — needs fresh auth
 }
 
+// DefaultAuthErrorPatterns are patterns that indicate an account failed to
+// authenticate (not logged in, bad key). Matched with (?i) against agent
+// output by gt account test, before the rate-limit patterns: expired or
+// revoked OAuth tokens also appear in DefaultRateLimitPatterns so the quota
+// scanner rotates away from them.
+var DefaultAuthErrorPatterns = []string{
+	`Invalid API key`,                     // Bad or missing ANTHROPIC_API_KEY
+	`OAuth token (revoked|has expired)`,   // Stale credentials — needs fresh auth
+	`Please run /login`,                   // Claude Code has no credentials
+	`Not logged in`,                       // Claude Code has no credentials
+	`"type"\s*:\s*"authentication_error"`, // Structured API 401
+	`API Error: 401`,                      // Mid-stream API 401
+}
+
 // DefaultNearLimitPatterns are patterns that indicate a session is approaching
 // its rate limit but hasn't hit it yet. These enable proactive rotation before
 // the hard 429. Matched with (?i) for case-insensitive matching.
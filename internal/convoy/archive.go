@@ -0,0 +1,25 @@
+package convoy
+
+import (
+	"slices"
+	"time"
+)
+
+// ArchivedLabel marks a closed convoy retired by gt convoy archive. Archived
+// convoys are kept for history but left out of default listings.
+const ArchivedLabel = "gt:archived"
+
+// IsArchived reports whether a convoy's labels include ArchivedLabel.
+func IsArchived(labels []string) bool {
+	return slices.Contains(labels, ArchivedLabel)
+}
+
+// ShouldArchive reports whether a convoy is due for archiving: it is closed,
+// not already archived, and closed before cutoff. A convoy with no close
+// time is never archived.
+func ShouldArchive(status string, closedAt time.Time, labels []string, cutoff time.Time) bool {
+	if status != "closed" || closedAt.IsZero() || IsArchived(labels) {
+		return false
+	}
+	return closedAt.Before(cutoff)
+}
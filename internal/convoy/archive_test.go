@@ -0,0 +1,43 @@
+package convoy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestShouldArchive(t *testing.T) {
+	cutoff := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	old := cutoff.Add(-48 * time.Hour)
+	recent := cutoff.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		status   string
+		closedAt time.Time
+		labels   []string
+		want     bool
+	}{
+		{"closed before cutoff", "closed", old, nil, true},
+		{"closed after cutoff", "closed", recent, nil, false},
+		{"closed exactly at cutoff", "closed", cutoff, nil, false},
+		{"open", "open", old, nil, false},
+		{"no close time", "closed", time.Time{}, nil, false},
+		{"already archived", "closed", old, []string{"gt:owned", ArchivedLabel}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ShouldArchive(tt.status, tt.closedAt, tt.labels, cutoff); got != tt.want {
+				t.Errorf("ShouldArchive = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsArchived(t *testing.T) {
+	if IsArchived(nil) || IsArchived([]string{"gt:owned"}) {
+		t.Error("IsArchived true without the archived label")
+	}
+	if !IsArchived([]string{"gt:owned", ArchivedLabel}) {
+		t.Error("IsArchived false with the archived label")
+	}
+}
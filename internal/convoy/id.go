@@ -0,0 +1,12 @@
+package convoy
+
+import "regexp"
+
+// idPattern matches convoy IDs (hq-<alnum/dash>). Restricting IDs to this
+// charset keeps them safe to pass to bd subprocesses and SQL queries.
+var idPattern = regexp.MustCompile(`^hq-[a-zA-Z0-9-]+$`)
+
+// ValidID reports whether id is a well-formed convoy ID.
+func ValidID(id string) bool {
+	return idPattern.MatchString(id)
+}
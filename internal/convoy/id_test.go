@@ -0,0 +1,30 @@
+package convoy
+
+import "testing"
+
+func TestValidID(t *testing.T) {
+	tests := []struct {
+		id   string
+		want bool
+	}{
+		{"hq-abc", true},
+		{"hq-cv-123", true},
+		{"hq-ABC-def-9", true},
+		{"", false},
+		{"hq-", false},
+		{"gt-abc", false},
+		{"HQ-abc", false},
+		{"hq-abc'; DROP TABLE issues; --", false},
+		{"hq-abc OR 1=1", false},
+		{"hq-abc\nhq-def", false},
+		{"hq-abc;rm -rf /", false},
+		{"hq-abc$(whoami)", false},
+		{"hq-abc/../x", false},
+		{"hq_abc", false},
+	}
+	for _, tt := range tests {
+		if got := ValidID(tt.id); got != tt.want {
+			t.Errorf("ValidID(%q) = %v, want %v", tt.id, got, tt.want)
+		}
+	}
+}
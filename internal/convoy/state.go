@@ -0,0 +1,190 @@
+package convoy
+
+import (
+	"fmt"
+	"time"
+)
+
+// WorkState summarizes what is happening on a convoy's tracked work.
+type WorkState string
+
+const (
+	// WorkStateActive means a worker has touched tracked work recently.
+	WorkStateActive WorkState = "active"
+
+	// WorkStateIdle means a worker is assigned but has been quiet for
+	// longer than IdleThreshold.
+	WorkStateIdle WorkState = "idle"
+
+	// WorkStateWaiting means open work remains but nobody is assigned.
+	WorkStateWaiting WorkState = "waiting"
+
+	// WorkStateStuck means a worker is assigned but has been quiet for
+	// longer than StuckThreshold.
+	WorkStateStuck WorkState = "stuck"
+
+	// WorkStateComplete means every tracked issue is closed.
+	WorkStateComplete WorkState = "complete"
+)
+
+const (
+	// IdleThreshold is how long assigned work may go without activity
+	// before the convoy is considered idle.
+	IdleThreshold = 5 * time.Minute
+
+	// StuckThreshold is how long assigned work may go without activity
+	// before the convoy is considered stuck.
+	StuckThreshold = 30 * time.Minute
+)
+
+// Clock reports the current time. CalculateState reads "now" through it so
+// callers (and tests) can pin the time instead of relying on time.Now.
+type Clock interface {
+	Now() time.Time
+}
+
+// realClock is the default Clock, backed by time.Now.
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+// StateInput is the tracked-work summary CalculateState derives a state from.
+type StateInput struct {
+	Completed int // Tracked issues that are closed
+	Total     int // All tracked issues
+
+	// HasWorker is true when at least one open tracked issue has an assignee.
+	HasWorker bool
+
+	// WorkerDead is true when the assigned worker is known to have no live
+	// session (the work is orphaned).
+	WorkerDead bool
+
+	// Blocked is true when all open tracked work is waiting on a gate or
+	// dependency.
+	Blocked bool
+
+	// LastActivity is the most recent update time across tracked issues.
+	// Zero means unknown.
+	LastActivity time.Time
+
+	// PinnedState is an operator-pinned state (gt convoy pin) that replaces
+	// the computed one until PinnedUntil. Empty means not pinned.
+	PinnedState WorkState
+
+	// PinnedUntil is when the pin expires. A pin with a zero expiry is
+	// ignored.
+	PinnedUntil time.Time
+
+	// Clock supplies the current time for idle calculations. Nil uses the
+	// real clock.
+	Clock Clock
+}
+
+// StateInfo is the computed work state of a convoy.
+type StateInfo struct {
+	State WorkState `json:"state"`
+
+	// Reason explains a waiting, idle, or stuck state (empty otherwise).
+	Reason string `json:"reason,omitempty"`
+
+	// IdleFor is the time since LastActivity (zero when unknown or complete).
+	IdleFor time.Duration `json:"idle_for,omitempty"`
+}
+
+// CalculateState derives a convoy's work state from its tracked-work summary.
+//
+// A convoy whose tracked issues are all closed is complete. A convoy with no
+// tracked issues, with work blocked on a gate, or with open work but no
+// assignee, is waiting. Work whose assigned worker has died is stuck.
+// Otherwise the state follows the time since last activity: active, then
+// idle past IdleThreshold, then stuck past StuckThreshold. Unknown activity
+// is treated as active so a convoy is never flagged stuck on missing data
+// alone.
+//
+// A pinned state overrides all of the above except complete until the pin
+// expires, after which the computed state applies again.
+func CalculateState(in StateInput) StateInfo {
+	if in.Total > 0 && in.Completed >= in.Total {
+		return StateInfo{State: WorkStateComplete}
+	}
+
+	clock := in.Clock
+	if clock == nil {
+		clock = realClock{}
+	}
+	now := clock.Now()
+
+	if in.PinnedState != "" && now.Before(in.PinnedUntil) {
+		return StateInfo{
+			State:   in.PinnedState,
+			Reason:  "pinned for " + formatIdle(in.PinnedUntil.Sub(now)),
+			IdleFor: idleSince(in.LastActivity, now),
+		}
+	}
+
+	if in.Total == 0 {
+		return StateInfo{State: WorkStateWaiting, Reason: "no tracked issues"}
+	}
+	if in.Blocked {
+		return StateInfo{State: WorkStateWaiting, Reason: "blocked on gate or dependency"}
+	}
+	if !in.HasWorker {
+		return StateInfo{State: WorkStateWaiting, Reason: "no worker assigned"}
+	}
+
+	idle := idleSince(in.LastActivity, now)
+	info := StateInfo{IdleFor: idle}
+	switch {
+	case in.WorkerDead:
+		info.State = WorkStateStuck
+		info.Reason = "assigned worker is not running"
+	case in.LastActivity.IsZero():
+		info.State = WorkStateActive
+	case idle >= StuckThreshold:
+		info.State = WorkStateStuck
+		info.Reason = "no activity for " + formatIdle(idle)
+	case idle >= IdleThreshold:
+		info.State = WorkStateIdle
+		info.Reason = "no activity for " + formatIdle(idle)
+	default:
+		info.State = WorkStateActive
+	}
+	return info
+}
+
+// idleSince returns the time from lastActivity to now, or zero when
+// lastActivity is unknown or in the future.
+func idleSince(lastActivity, now time.Time) time.Duration {
+	if lastActivity.IsZero() || now.Before(lastActivity) {
+		return 0
+	}
+	return now.Sub(lastActivity)
+}
+
+// PinnableStates are the work states an operator may pin a convoy to.
+// Complete is excluded: it is always derived from tracked issues.
+var PinnableStates = []WorkState{WorkStateActive, WorkStateIdle, WorkStateWaiting, WorkStateStuck}
+
+// ParsePinnableState returns the work state named by s if it can be pinned.
+func ParsePinnableState(s string) (WorkState, bool) {
+	for _, st := range PinnableStates {
+		if string(st) == s {
+			return st, true
+		}
+	}
+	return "", false
+}
+
+// formatIdle formats an idle duration compactly: "7m", "2h5m", "3d4h".
+func formatIdle(d time.Duration) string {
+	d = d.Truncate(time.Minute)
+	switch {
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh%dm", int(d.Hours()), int(d.Minutes())%60)
+	default:
+		return fmt.Sprintf("%dd%dh", int(d.Hours())/24, int(d.Hours())%24)
+	}
+}
@@ -0,0 +1,194 @@
+package convoy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCalculateState(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name string
+		in   StateInput
+		want WorkState
+	}{
+		{"all closed", StateInput{Completed: 3, Total: 3, HasWorker: true}, WorkStateComplete},
+		{"no tracked issues", StateInput{}, WorkStateWaiting},
+		{"open work, no worker", StateInput{Completed: 1, Total: 3, LastActivity: now}, WorkStateWaiting},
+		{"recent activity", StateInput{Total: 2, HasWorker: true, LastActivity: now.Add(-time.Minute)}, WorkStateActive},
+		{"unknown activity", StateInput{Total: 2, HasWorker: true}, WorkStateActive},
+		{"past idle threshold", StateInput{Total: 2, HasWorker: true, LastActivity: now.Add(-IdleThreshold - time.Minute)}, WorkStateIdle},
+		{"past stuck threshold", StateInput{Total: 2, HasWorker: true, LastActivity: now.Add(-StuckThreshold - time.Minute)}, WorkStateStuck},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CalculateState(tt.in).State; got != tt.want {
+				t.Errorf("CalculateState() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateState_Reason(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		in         StateInput
+		wantState  WorkState
+		wantReason string
+	}{
+		{
+			name:       "waiting, no worker",
+			in:         StateInput{Total: 2, LastActivity: now},
+			wantState:  WorkStateWaiting,
+			wantReason: "no worker assigned",
+		},
+		{
+			name:       "waiting, blocked",
+			in:         StateInput{Total: 2, HasWorker: true, Blocked: true, LastActivity: now},
+			wantState:  WorkStateWaiting,
+			wantReason: "blocked on gate or dependency",
+		},
+		{
+			name:       "orphaned worker",
+			in:         StateInput{Total: 2, HasWorker: true, WorkerDead: true, LastActivity: now},
+			wantState:  WorkStateStuck,
+			wantReason: "assigned worker is not running",
+		},
+		{
+			name:       "stuck, no activity",
+			in:         StateInput{Total: 2, HasWorker: true, LastActivity: now.Add(-2*time.Hour - 5*time.Minute - 30*time.Second)},
+			wantState:  WorkStateStuck,
+			wantReason: "no activity for 2h5m",
+		},
+		{
+			name:       "active has no reason",
+			in:         StateInput{Total: 2, HasWorker: true, LastActivity: now},
+			wantState:  WorkStateActive,
+			wantReason: "",
+		},
+		{
+			name:       "complete has no reason",
+			in:         StateInput{Completed: 2, Total: 2},
+			wantState:  WorkStateComplete,
+			wantReason: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CalculateState(tt.in)
+			if got.State != tt.wantState || got.Reason != tt.wantReason {
+				t.Errorf("CalculateState() = %s (%q), want %s (%q)", got.State, got.Reason, tt.wantState, tt.wantReason)
+			}
+		})
+	}
+}
+
+// fakeClock is a Clock whose time only moves when advanced.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func TestCalculateState_FakeClock(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := &fakeClock{now: start}
+	in := StateInput{Total: 2, HasWorker: true, LastActivity: start, Clock: clock}
+
+	steps := []struct {
+		advance time.Duration
+		want    WorkState
+		idleFor time.Duration
+	}{
+		{0, WorkStateActive, 0},
+		{IdleThreshold - time.Nanosecond, WorkStateActive, IdleThreshold - time.Nanosecond},
+		{time.Nanosecond, WorkStateIdle, IdleThreshold},
+		{StuckThreshold - IdleThreshold - time.Nanosecond, WorkStateIdle, StuckThreshold - time.Nanosecond},
+		{time.Nanosecond, WorkStateStuck, StuckThreshold},
+	}
+	for _, step := range steps {
+		clock.Advance(step.advance)
+		got := CalculateState(in)
+		if got.State != step.want || got.IdleFor != step.idleFor {
+			t.Errorf("at +%s: got %s (idle %s), want %s (idle %s)",
+				clock.now.Sub(start), got.State, got.IdleFor, step.want, step.idleFor)
+		}
+	}
+}
+
+func TestCalculateState_FakeClockBeforeActivity(t *testing.T) {
+	// Activity timestamps ahead of the clock (skew) clamp to zero idle.
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	got := CalculateState(StateInput{
+		Total: 1, HasWorker: true, LastActivity: now.Add(time.Minute), Clock: &fakeClock{now: now},
+	})
+	if got.State != WorkStateActive || got.IdleFor != 0 {
+		t.Errorf("got %s (idle %s), want active with zero idle", got.State, got.IdleFor)
+	}
+}
+
+func TestCalculateState_Pinned(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := &fakeClock{now: start}
+	in := StateInput{
+		Total:        2,
+		HasWorker:    true,
+		LastActivity: start.Add(-2 * time.Hour), // would be stuck
+		PinnedState:  WorkStateActive,
+		PinnedUntil:  start.Add(time.Hour),
+		Clock:        clock,
+	}
+
+	got := CalculateState(in)
+	if got.State != WorkStateActive {
+		t.Fatalf("pinned: got %s, want active", got.State)
+	}
+	if got.Reason != "pinned for 1h0m" {
+		t.Errorf("pinned reason = %q, want %q", got.Reason, "pinned for 1h0m")
+	}
+	if got.IdleFor != 2*time.Hour {
+		t.Errorf("pinned IdleFor = %s, want 2h", got.IdleFor)
+	}
+
+	// The pin expires at PinnedUntil and the computed state returns.
+	clock.Advance(time.Hour)
+	if got := CalculateState(in); got.State != WorkStateStuck {
+		t.Errorf("after expiry: got %s, want stuck", got.State)
+	}
+}
+
+func TestCalculateState_PinnedDoesNotHideComplete(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	got := CalculateState(StateInput{
+		Completed: 2, Total: 2, HasWorker: true,
+		PinnedState: WorkStateStuck, PinnedUntil: now.Add(time.Hour), Clock: &fakeClock{now: now},
+	})
+	if got.State != WorkStateComplete {
+		t.Errorf("got %s, want complete", got.State)
+	}
+}
+
+func TestCalculateState_PinWithoutExpiryIgnored(t *testing.T) {
+	got := CalculateState(StateInput{Total: 2, PinnedState: WorkStateActive})
+	if got.State != WorkStateWaiting {
+		t.Errorf("got %s, want waiting (pin without expiry ignored)", got.State)
+	}
+}
+
+func TestParsePinnableState(t *testing.T) {
+	for _, s := range []string{"active", "idle", "waiting", "stuck"} {
+		if got, ok := ParsePinnableState(s); !ok || string(got) != s {
+			t.Errorf("ParsePinnableState(%q) = %q, %v", s, got, ok)
+		}
+	}
+	for _, s := range []string{"complete", "", "ACTIVE", "busy"} {
+		if _, ok := ParsePinnableState(s); ok {
+			t.Errorf("ParsePinnableState(%q) accepted", s)
+		}
+	}
+}
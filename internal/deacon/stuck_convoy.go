@@ -0,0 +1,277 @@
+package deacon
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// StuckConvoyState tracks stuck-convoy episodes between deacon patrols.
+// Persisted to deacon/stuck-convoy-state.json.
+type StuckConvoyState struct {
+	// Convoys maps convoy ID to its current stuck episode. A convoy has an
+	// entry only while it stays stuck.
+	Convoys map[string]*StuckConvoyEpisode `json:"convoys"`
+
+	// LastUpdated is when this state was last written.
+	LastUpdated time.Time `json:"last_updated"`
+}
+
+// StuckConvoyEpisode records one continuous period in which a convoy was
+// stuck, and what the deacon has done about it.
+type StuckConvoyEpisode struct {
+	// ConvoyID is the convoy identifier.
+	ConvoyID string `json:"convoy_id"`
+
+	// NudgedAt is when the convoy was first seen stuck and its workers nudged.
+	NudgedAt time.Time `json:"nudged_at"`
+
+	// Nudged lists the workers that were nudged.
+	Nudged []string `json:"nudged,omitempty"`
+
+	// EscalatedAt is when the convoy was escalated (zero if not yet).
+	EscalatedAt time.Time `json:"escalated_at,omitempty"`
+}
+
+// ConvoyObservation is the deacon's view of one open convoy during a patrol.
+type ConvoyObservation struct {
+	ID      string
+	Title   string
+	Stuck   bool
+	Reason  string
+	Workers []string
+
+	// Unknown is set when the convoy's state could not be read this patrol.
+	// Its episode, if any, is kept as it is until the convoy is seen again.
+	Unknown bool
+}
+
+// StuckNudgeResult describes the outcome of a nudge-stuck invocation.
+type StuckNudgeResult struct {
+	// Nudged is the number of convoys whose workers were nudged.
+	Nudged int `json:"nudged"`
+
+	// Escalated is the number of convoys escalated after the nudge window.
+	Escalated int `json:"escalated"`
+
+	// Waiting is the number of stuck convoys still inside their nudge window,
+	// already escalated, or whose state could not be read this patrol.
+	Waiting int `json:"waiting"`
+
+	// Recovered is the number of episodes ended because the convoy is no
+	// longer stuck (or no longer open).
+	Recovered int `json:"recovered"`
+
+	// Errors is the number of nudges or escalations that failed.
+	Errors int `json:"errors"`
+
+	// Details has per-convoy results.
+	Details []StuckConvoyResult `json:"details"`
+}
+
+// StuckConvoyResult describes the outcome for a single convoy.
+type StuckConvoyResult struct {
+	ConvoyID string `json:"convoy_id"`
+	Action   string `json:"action"` // "nudged", "escalated", "waiting", "recovered", "error"
+	Message  string `json:"message"`
+}
+
+// nudgeStuckWorker nudges one worker of a stuck convoy.
+// Tests override this variable to avoid spawning gt.
+var nudgeStuckWorker = func(townRoot, worker, convoyID, reason string) error {
+	msg := fmt.Sprintf("Convoy %s looks stuck (%s). Check your hook and report progress, or escalate if blocked.", convoyID, reason)
+	cmd := exec.Command("gt", "nudge", worker, msg)
+	cmd.Dir = townRoot
+	return cmd.Run()
+}
+
+// escalateStuckConvoy raises an escalation for a convoy that stayed stuck
+// past its nudge window. Tests override this variable to avoid spawning gt.
+var escalateStuckConvoy = func(townRoot, convoyID, title, reason string) error {
+	cmd := exec.Command("gt", "escalate", fmt.Sprintf("Convoy %s stuck: %s", convoyID, title),
+		"--severity", "high",
+		"--reason", reason,
+		"--source", "patrol:deacon",
+		"--related", convoyID)
+	cmd.Dir = townRoot
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// StuckConvoyStateFile returns the path to the stuck-convoy state file.
+func StuckConvoyStateFile(townRoot string) string {
+	return filepath.Join(townRoot, "deacon", "stuck-convoy-state.json")
+}
+
+// LoadStuckConvoyState loads the stuck-convoy state from disk.
+// Returns empty state if file doesn't exist.
+func LoadStuckConvoyState(townRoot string) (*StuckConvoyState, error) {
+	data, err := os.ReadFile(StuckConvoyStateFile(townRoot)) //nolint:gosec // G304: path is constructed from trusted townRoot
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &StuckConvoyState{Convoys: make(map[string]*StuckConvoyEpisode)}, nil
+		}
+		return nil, fmt.Errorf("reading stuck-convoy state: %w", err)
+	}
+
+	var state StuckConvoyState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("parsing stuck-convoy state: %w", err)
+	}
+	if state.Convoys == nil {
+		state.Convoys = make(map[string]*StuckConvoyEpisode)
+	}
+	return &state, nil
+}
+
+// SaveStuckConvoyState saves the stuck-convoy state to disk.
+func SaveStuckConvoyState(townRoot string, state *StuckConvoyState) error {
+	stateFile := StuckConvoyStateFile(townRoot)
+	if err := os.MkdirAll(filepath.Dir(stateFile), 0755); err != nil {
+		return fmt.Errorf("creating deacon directory: %w", err)
+	}
+
+	state.LastUpdated = time.Now().UTC()
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling stuck-convoy state: %w", err)
+	}
+	return os.WriteFile(stateFile, data, 0600)
+}
+
+// NudgeStuckConvoys nudges the workers of newly stuck convoys and escalates
+// convoys that stay stuck past window. Each stuck episode gets one nudge and
+// at most one escalation; the episode ends when the convoy is no longer stuck.
+// convoys must list every open convoy: one that is missing is taken to be
+// closed, while one marked Unknown keeps its episode.
+func NudgeStuckConvoys(townRoot string, convoys []ConvoyObservation, window time.Duration) (*StuckNudgeResult, error) {
+	state, err := LoadStuckConvoyState(townRoot)
+	if err != nil {
+		return nil, err
+	}
+	result := watchStuckConvoys(townRoot, state, convoys, window, time.Now().UTC())
+	if err := SaveStuckConvoyState(townRoot, state); err != nil {
+		return result, fmt.Errorf("saving stuck-convoy state: %w", err)
+	}
+	return result, nil
+}
+
+// watchStuckConvoys advances the stuck episodes in state from one patrol's
+// observations at time now.
+func watchStuckConvoys(townRoot string, state *StuckConvoyState, convoys []ConvoyObservation, window time.Duration, now time.Time) *StuckNudgeResult {
+	result := &StuckNudgeResult{}
+	if window <= 0 {
+		window = config.DefaultStuckConvoyNudgeWindow
+	}
+	if state.Convoys == nil {
+		state.Convoys = make(map[string]*StuckConvoyEpisode)
+	}
+
+	stuck := make(map[string]bool, len(convoys))
+	unknown := make(map[string]bool)
+	for _, c := range convoys {
+		switch {
+		case c.Unknown:
+			unknown[c.ID] = true
+		case c.Stuck:
+			stuck[c.ID] = true
+		}
+	}
+
+	// End episodes for convoys that recovered or are no longer open. A
+	// convoy that could not be read has not been seen to recover.
+	var ended []string
+	for id := range state.Convoys {
+		if !stuck[id] && !unknown[id] {
+			ended = append(ended, id)
+		}
+	}
+	sort.Strings(ended)
+	for _, id := range ended {
+		delete(state.Convoys, id)
+		result.Recovered++
+		result.Details = append(result.Details, StuckConvoyResult{
+			ConvoyID: id,
+			Action:   "recovered",
+			Message:  "no longer stuck",
+		})
+	}
+
+	for _, c := range convoys {
+		if c.Unknown {
+			if _, ok := state.Convoys[c.ID]; ok {
+				result.Waiting++
+				result.Details = append(result.Details, StuckConvoyResult{
+					ConvoyID: c.ID,
+					Action:   "waiting",
+					Message:  "could not read convoy state; keeping stuck episode",
+				})
+			}
+			continue
+		}
+		if !c.Stuck {
+			continue
+		}
+
+		episode, ok := state.Convoys[c.ID]
+		if !ok {
+			// Transition into stuck: nudge every worker once.
+			episode = &StuckConvoyEpisode{ConvoyID: c.ID, NudgedAt: now}
+			state.Convoys[c.ID] = episode
+			for _, worker := range c.Workers {
+				if err := nudgeStuckWorker(townRoot, worker, c.ID, c.Reason); err != nil {
+					result.Errors++
+					result.Details = append(result.Details, StuckConvoyResult{
+						ConvoyID: c.ID,
+						Action:   "error",
+						Message:  fmt.Sprintf("nudging %s: %v", worker, err),
+					})
+					continue
+				}
+				episode.Nudged = append(episode.Nudged, worker)
+			}
+			result.Nudged++
+			msg := fmt.Sprintf("nudged %d worker(s); escalating if still stuck after %s", len(episode.Nudged), window)
+			if len(c.Workers) == 0 {
+				msg = fmt.Sprintf("no workers to nudge; escalating if still stuck after %s", window)
+			}
+			result.Details = append(result.Details, StuckConvoyResult{ConvoyID: c.ID, Action: "nudged", Message: msg})
+			continue
+		}
+
+		if !episode.EscalatedAt.IsZero() || now.Sub(episode.NudgedAt) < window {
+			result.Waiting++
+			msg := "already escalated"
+			if episode.EscalatedAt.IsZero() {
+				msg = fmt.Sprintf("nudged %s ago; waiting for nudge window", now.Sub(episode.NudgedAt).Round(time.Second))
+			}
+			result.Details = append(result.Details, StuckConvoyResult{ConvoyID: c.ID, Action: "waiting", Message: msg})
+			continue
+		}
+
+		reason := fmt.Sprintf("Convoy still stuck %s after nudging its workers (%s)", now.Sub(episode.NudgedAt).Round(time.Second), c.Reason)
+		if err := escalateStuckConvoy(townRoot, c.ID, c.Title, reason); err != nil {
+			// Leave EscalatedAt unset so the next patrol retries.
+			result.Errors++
+			result.Details = append(result.Details, StuckConvoyResult{
+				ConvoyID: c.ID,
+				Action:   "error",
+				Message:  fmt.Sprintf("escalating: %v", err),
+			})
+			continue
+		}
+		episode.EscalatedAt = now
+		result.Escalated++
+		result.Details = append(result.Details, StuckConvoyResult{ConvoyID: c.ID, Action: "escalated", Message: reason})
+	}
+
+	return result
+}
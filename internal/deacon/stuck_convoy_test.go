@@ -0,0 +1,188 @@
+package deacon
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// stubStuckConvoyActions replaces the gt nudge/escalate hooks with recorders.
+func stubStuckConvoyActions(t *testing.T) (nudges, escalations *[]string) {
+	t.Helper()
+	var n, e []string
+	origNudge, origEscalate := nudgeStuckWorker, escalateStuckConvoy
+	nudgeStuckWorker = func(_, worker, convoyID, _ string) error {
+		n = append(n, convoyID+"→"+worker)
+		return nil
+	}
+	escalateStuckConvoy = func(_, convoyID, _, _ string) error {
+		e = append(e, convoyID)
+		return nil
+	}
+	t.Cleanup(func() {
+		nudgeStuckWorker, escalateStuckConvoy = origNudge, origEscalate
+	})
+	return &n, &e
+}
+
+func TestWatchStuckConvoys_SingleNudgePerEpisode(t *testing.T) {
+	nudges, escalations := stubStuckConvoyActions(t)
+	state := &StuckConvoyState{}
+	window := 15 * time.Minute
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	active := []ConvoyObservation{{ID: "hq-cv-a", Workers: []string{"gastown/polecats/nux"}}}
+	stuck := []ConvoyObservation{{ID: "hq-cv-a", Stuck: true, Reason: "no activity", Workers: []string{"gastown/polecats/nux"}}}
+
+	watchStuckConvoys("", state, active, window, start)
+	if len(*nudges) != 0 {
+		t.Fatalf("nudged an active convoy: %v", *nudges)
+	}
+
+	// active → stuck nudges once; later patrols inside the window do not.
+	r := watchStuckConvoys("", state, stuck, window, start.Add(time.Minute))
+	if r.Nudged != 1 {
+		t.Errorf("Nudged = %d, want 1", r.Nudged)
+	}
+	for _, d := range []time.Duration{2 * time.Minute, 5 * time.Minute, 10 * time.Minute} {
+		r = watchStuckConvoys("", state, stuck, window, start.Add(d))
+		if r.Nudged != 0 || r.Waiting != 1 {
+			t.Errorf("at +%s: Nudged = %d, Waiting = %d, want 0, 1", d, r.Nudged, r.Waiting)
+		}
+	}
+	if len(*nudges) != 1 || (*nudges)[0] != "hq-cv-a→gastown/polecats/nux" {
+		t.Errorf("nudges = %v, want exactly one to gastown/polecats/nux", *nudges)
+	}
+	if len(*escalations) != 0 {
+		t.Errorf("escalated inside the nudge window: %v", *escalations)
+	}
+
+	// Recovery ends the episode; the next stuck transition nudges again.
+	r = watchStuckConvoys("", state, active, window, start.Add(11*time.Minute))
+	if r.Recovered != 1 {
+		t.Errorf("Recovered = %d, want 1", r.Recovered)
+	}
+	watchStuckConvoys("", state, stuck, window, start.Add(12*time.Minute))
+	if len(*nudges) != 2 {
+		t.Errorf("nudges = %v, want a second nudge for the new episode", *nudges)
+	}
+}
+
+func TestWatchStuckConvoys_EscalatesAfterWindow(t *testing.T) {
+	nudges, escalations := stubStuckConvoyActions(t)
+	state := &StuckConvoyState{}
+	window := 15 * time.Minute
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	stuck := []ConvoyObservation{{ID: "hq-cv-a", Stuck: true, Workers: []string{"gastown/polecats/nux"}}}
+
+	watchStuckConvoys("", state, stuck, window, start)
+	r := watchStuckConvoys("", state, stuck, window, start.Add(14*time.Minute))
+	if r.Escalated != 0 {
+		t.Fatalf("escalated before the window elapsed")
+	}
+
+	r = watchStuckConvoys("", state, stuck, window, start.Add(window))
+	if r.Escalated != 1 {
+		t.Fatalf("Escalated = %d, want 1 once the window elapsed", r.Escalated)
+	}
+	if ep := state.Convoys["hq-cv-a"]; ep == nil || ep.EscalatedAt.IsZero() {
+		t.Errorf("episode not marked escalated: %+v", ep)
+	}
+
+	// Escalation happens once per episode, and no further nudges are sent.
+	watchStuckConvoys("", state, stuck, window, start.Add(2*window))
+	if len(*escalations) != 1 {
+		t.Errorf("escalations = %v, want exactly one", *escalations)
+	}
+	if len(*nudges) != 1 {
+		t.Errorf("nudges = %v, want exactly one", *nudges)
+	}
+}
+
+func TestWatchStuckConvoys_EscalationFailureRetries(t *testing.T) {
+	stubStuckConvoyActions(t)
+	calls := 0
+	escalateStuckConvoy = func(_, _, _, _ string) error {
+		calls++
+		if calls == 1 {
+			return errors.New("escalate failed")
+		}
+		return nil
+	}
+
+	state := &StuckConvoyState{}
+	window := time.Minute
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	stuck := []ConvoyObservation{{ID: "hq-cv-a", Stuck: true}}
+
+	watchStuckConvoys("", state, stuck, window, start)
+	r := watchStuckConvoys("", state, stuck, window, start.Add(window))
+	if r.Errors != 1 || r.Escalated != 0 {
+		t.Fatalf("Errors = %d, Escalated = %d, want 1, 0", r.Errors, r.Escalated)
+	}
+	r = watchStuckConvoys("", state, stuck, window, start.Add(2*window))
+	if r.Escalated != 1 {
+		t.Errorf("Escalated = %d, want 1 on retry", r.Escalated)
+	}
+}
+
+func TestWatchStuckConvoys_ClosedConvoyEndsEpisode(t *testing.T) {
+	stubStuckConvoyActions(t)
+	state := &StuckConvoyState{}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	watchStuckConvoys("", state, []ConvoyObservation{{ID: "hq-cv-a", Stuck: true}}, time.Minute, now)
+	watchStuckConvoys("", state, nil, time.Minute, now.Add(time.Second))
+	if len(state.Convoys) != 0 {
+		t.Errorf("state.Convoys = %v, want empty after convoy closed", state.Convoys)
+	}
+}
+
+func TestWatchStuckConvoys_UnknownKeepsEpisode(t *testing.T) {
+	nudges, _ := stubStuckConvoyActions(t)
+	state := &StuckConvoyState{}
+	window := 15 * time.Minute
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	stuck := []ConvoyObservation{{ID: "hq-cv-a", Stuck: true, Workers: []string{"gastown/polecats/nux"}}}
+	unread := []ConvoyObservation{{ID: "hq-cv-a", Unknown: true}}
+
+	watchStuckConvoys("", state, stuck, window, start)
+	r := watchStuckConvoys("", state, unread, window, start.Add(time.Minute))
+	if r.Recovered != 0 || r.Waiting != 1 {
+		t.Errorf("Recovered = %d, Waiting = %d, want 0, 1 for an unreadable convoy", r.Recovered, r.Waiting)
+	}
+	if _, ok := state.Convoys["hq-cv-a"]; !ok {
+		t.Fatal("episode ended for a convoy that could not be read")
+	}
+
+	watchStuckConvoys("", state, stuck, window, start.Add(2*time.Minute))
+	if len(*nudges) != 1 {
+		t.Errorf("nudges = %v, want one nudge for the whole episode", *nudges)
+	}
+
+	// An unreadable convoy without an episode does not start one.
+	r = watchStuckConvoys("", &StuckConvoyState{}, unread, window, start)
+	if r.Nudged != 0 || r.Waiting != 0 {
+		t.Errorf("Nudged = %d, Waiting = %d, want 0, 0 without an episode", r.Nudged, r.Waiting)
+	}
+}
+
+func TestSaveThenLoadStuckConvoyState(t *testing.T) {
+	tmpDir := t.TempDir()
+	nudgedAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	state := &StuckConvoyState{Convoys: map[string]*StuckConvoyEpisode{
+		"hq-cv-a": {ConvoyID: "hq-cv-a", NudgedAt: nudgedAt, Nudged: []string{"gastown/polecats/nux"}},
+	}}
+	if err := SaveStuckConvoyState(tmpDir, state); err != nil {
+		t.Fatalf("save error: %v", err)
+	}
+
+	loaded, err := LoadStuckConvoyState(tmpDir)
+	if err != nil {
+		t.Fatalf("load error: %v", err)
+	}
+	ep := loaded.Convoys["hq-cv-a"]
+	if ep == nil || !ep.NudgedAt.Equal(nudgedAt) || len(ep.Nudged) != 1 {
+		t.Errorf("loaded episode = %+v", ep)
+	}
+}
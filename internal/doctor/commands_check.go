@@ -2,6 +2,7 @@ package doctor
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/steveyegge/gastown/internal/templates"
@@ -66,3 +67,12 @@ func (c *CommandsCheck) Fix(ctx *CheckContext) error {
 
 	return templates.ProvisionCommands(c.townRoot)
 }
+
+// FixPlan lists the slash command files Fix would create.
+func (c *CommandsCheck) FixPlan(ctx *CheckContext) []string {
+	var plan []string
+	for _, name := range c.missingCommands {
+		plan = append(plan, "write "+filepath.Join(c.townRoot, ".claude", "commands", name+".md"))
+	}
+	return plan
+}
@@ -0,0 +1,50 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/templates"
+)
+
+func TestCommandsCheck_FixPlan(t *testing.T) {
+	townRoot := t.TempDir()
+
+	check := NewCommandsCheck()
+	ctx := &CheckContext{TownRoot: townRoot}
+	if result := check.Run(ctx); result.Status != StatusWarning {
+		t.Fatalf("expected StatusWarning, got %v: %s", result.Status, result.Message)
+	}
+
+	names := templates.CommandNames()
+	plan := check.FixPlan(ctx)
+	if len(plan) != len(names) {
+		t.Fatalf("expected %d plan steps, got %v", len(names), plan)
+	}
+	want := "write " + filepath.Join(townRoot, ".claude", "commands", names[0]+".md")
+	if plan[0] != want {
+		t.Errorf("plan[0] = %q, want %q", plan[0], want)
+	}
+
+	// Planning must not create the commands directory.
+	if _, err := os.Stat(filepath.Join(townRoot, ".claude")); !os.IsNotExist(err) {
+		t.Errorf("FixPlan created .claude/ (stat err: %v)", err)
+	}
+}
+
+func TestCommandsCheck_FixPlanEmptyWhenProvisioned(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := templates.ProvisionCommands(townRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	check := NewCommandsCheck()
+	ctx := &CheckContext{TownRoot: townRoot}
+	if result := check.Run(ctx); result.Status != StatusOK {
+		t.Fatalf("expected StatusOK, got %v: %s", result.Status, result.Message)
+	}
+	if plan := check.FixPlan(ctx); len(plan) != 0 {
+		t.Errorf("expected empty plan, got %v", plan)
+	}
+}
@@ -173,6 +173,16 @@ func (c *CrewStateCheck) Fix(ctx *CheckContext) error {
 	return lastErr
 }
 
+// FixPlan lists the state.json files Fix would regenerate.
+func (c *CrewStateCheck) FixPlan(ctx *CheckContext) []string {
+	var plan []string
+	for _, ic := range c.invalidCrews {
+		plan = append(plan, fmt.Sprintf("rewrite %s (name=%s, rig=%s, clone_path=%s)",
+			ic.stateFile, ic.crewName, ic.rigName, ic.path))
+	}
+	return plan
+}
+
 type crewDir struct {
 	path     string
 	rigName  string
@@ -0,0 +1,61 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCrewStateCheck_FixPlan(t *testing.T) {
+	townRoot := t.TempDir()
+	crewPath := filepath.Join(townRoot, "gastown", "crew", "max")
+	if err := os.MkdirAll(crewPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	stateFile := filepath.Join(crewPath, "state.json")
+	original := []byte(`{"name": "max"}`)
+	if err := os.WriteFile(stateFile, original, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	check := NewCrewStateCheck()
+	ctx := &CheckContext{TownRoot: townRoot}
+	if result := check.Run(ctx); result.Status != StatusWarning {
+		t.Fatalf("expected StatusWarning, got %v: %s", result.Status, result.Message)
+	}
+
+	plan := check.FixPlan(ctx)
+	if len(plan) != 1 {
+		t.Fatalf("expected 1 plan step, got %v", plan)
+	}
+	if !strings.Contains(plan[0], stateFile) || !strings.Contains(plan[0], "rig=gastown") {
+		t.Errorf("unexpected plan step: %q", plan[0])
+	}
+
+	// Planning must not touch the state file.
+	data, err := os.ReadFile(stateFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != string(original) {
+		t.Errorf("FixPlan modified state.json: %s", data)
+	}
+}
+
+func TestCrewStateCheck_FixPlanEmptyWhenValid(t *testing.T) {
+	townRoot := t.TempDir()
+	crewPath := filepath.Join(townRoot, "gastown", "crew", "max")
+	if err := os.MkdirAll(crewPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	check := NewCrewStateCheck()
+	ctx := &CheckContext{TownRoot: townRoot}
+	if result := check.Run(ctx); result.Status != StatusOK {
+		t.Fatalf("expected StatusOK, got %v: %s", result.Status, result.Message)
+	}
+	if plan := check.FixPlan(ctx); len(plan) != 0 {
+		t.Errorf("expected empty plan, got %v", plan)
+	}
+}
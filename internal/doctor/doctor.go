@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/steveyegge/gastown/internal/ui"
@@ -36,6 +37,45 @@ func (d *Doctor) Checks() []Check {
 	return d.checks
 }
 
+// Filter narrows the registered checks by name. When only is non-empty, just
+// those checks are kept; checks named in skip are then removed. Every name
+// must match a registered check, otherwise Filter returns an error and
+// leaves the check list unchanged. Registration order is preserved.
+func (d *Doctor) Filter(only, skip []string) error {
+	registered := make(map[string]bool, len(d.checks))
+	for _, check := range d.checks {
+		registered[check.Name()] = true
+	}
+	var unknown []string
+	toSet := func(names []string) map[string]bool {
+		set := make(map[string]bool, len(names))
+		for _, name := range names {
+			if !registered[name] {
+				unknown = append(unknown, name)
+			}
+			set[name] = true
+		}
+		return set
+	}
+	onlySet, skipSet := toSet(only), toSet(skip)
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown check(s): %s", strings.Join(unknown, ", "))
+	}
+
+	kept := make([]Check, 0, len(d.checks))
+	for _, check := range d.checks {
+		if len(onlySet) > 0 && !onlySet[check.Name()] {
+			continue
+		}
+		if skipSet[check.Name()] {
+			continue
+		}
+		kept = append(kept, check)
+	}
+	d.checks = kept
+	return nil
+}
+
 // categoryGetter interface for checks that provide a category
 type categoryGetter interface {
 	Category() string
@@ -111,6 +151,33 @@ func (d *Doctor) Fix(ctx *CheckContext) *Report {
 	return d.FixStreaming(ctx, nil, 0)
 }
 
+// FixDryRun runs all checks and, for failing fixable checks, records what
+// Fix would change in each result's Plan instead of applying the fix.
+func (d *Doctor) FixDryRun(ctx *CheckContext) *Report {
+	return d.FixDryRunStreaming(ctx, nil, 0)
+}
+
+// FixDryRunStreaming is FixDryRun with optional real-time output (see
+// RunStreaming). Checks that can fix but do not implement DryRunnable get a
+// placeholder plan so the report never implies a fix would be a no-op.
+func (d *Doctor) FixDryRunStreaming(ctx *CheckContext, w io.Writer, slowThreshold time.Duration) *Report {
+	report := d.RunStreaming(ctx, w, slowThreshold)
+
+	for i, check := range d.checks {
+		result := report.Checks[i]
+		if result.Status == StatusOK || !check.CanFix() {
+			continue
+		}
+		if dr, ok := check.(DryRunnable); ok {
+			result.Plan = dr.FixPlan(ctx)
+		} else {
+			result.Plan = []string{"apply fix (no dry-run plan available for this check)"}
+		}
+	}
+
+	return report
+}
+
 // safeFixCheck calls check.Fix() with panic recovery. If the Fix method panics
 // (e.g., due to a Dolt nil pointer dereference propagating in-process — GH#1769),
 // the panic is caught and returned as an error instead of crashing gt doctor.
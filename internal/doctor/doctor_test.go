@@ -270,6 +270,63 @@ func TestDoctor_RegisterAll(t *testing.T) {
 	}
 }
 
+func TestDoctor_Filter(t *testing.T) {
+	names := func(d *Doctor) string {
+		var out []string
+		for _, c := range d.Checks() {
+			out = append(out, c.Name())
+		}
+		return strings.Join(out, ",")
+	}
+	newDoctor := func() *Doctor {
+		d := NewDoctor()
+		d.RegisterAll(
+			newMockCheck("town-config-exists", StatusOK),
+			newMockCheck("orphan-sessions", StatusOK),
+			newMockCheck("orphan-processes", StatusOK),
+			newMockCheck("daemon", StatusOK),
+		)
+		return d
+	}
+
+	tests := []struct {
+		name       string
+		only, skip []string
+		want       string
+	}{
+		{name: "no filter", want: "town-config-exists,orphan-sessions,orphan-processes,daemon"},
+		{name: "only one", only: []string{"orphan-sessions"}, want: "orphan-sessions"},
+		{name: "only keeps registration order", only: []string{"daemon", "town-config-exists"}, want: "town-config-exists,daemon"},
+		{name: "skip", skip: []string{"daemon", "orphan-processes"}, want: "town-config-exists,orphan-sessions"},
+		{name: "only and skip", only: []string{"orphan-sessions", "orphan-processes"}, skip: []string{"orphan-processes"}, want: "orphan-sessions"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newDoctor()
+			if err := d.Filter(tt.only, tt.skip); err != nil {
+				t.Fatalf("Filter() error = %v", err)
+			}
+			if got := names(d); got != tt.want {
+				t.Errorf("checks = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("unknown name", func(t *testing.T) {
+		d := newDoctor()
+		err := d.Filter([]string{"orphan-sessions", "no-such-check"}, []string{"also-missing"})
+		if err == nil {
+			t.Fatal("Filter() should reject unknown check names")
+		}
+		if !strings.Contains(err.Error(), "no-such-check") || !strings.Contains(err.Error(), "also-missing") {
+			t.Errorf("error = %v, want it to name every unknown check", err)
+		}
+		if len(d.Checks()) != 4 {
+			t.Errorf("failed Filter() should leave checks unchanged, got %s", names(d))
+		}
+	})
+}
+
 func TestDoctor_Run(t *testing.T) {
 	d := NewDoctor()
 	d.Register(newMockCheck("ok", StatusOK))
@@ -332,6 +389,37 @@ func TestDoctor_Fix(t *testing.T) {
 	}
 }
 
+func TestDoctor_FixDryRun(t *testing.T) {
+	d := NewDoctor()
+
+	okCheck := newMockCheck("ok", StatusOK)
+	okCheck.fixable = true
+	d.Register(okCheck)
+
+	fixableCheck := newMockCheck("fixable", StatusWarning)
+	fixableCheck.fixable = true
+	d.Register(fixableCheck)
+
+	unfixableCheck := newMockCheck("unfixable", StatusError)
+	d.Register(unfixableCheck)
+
+	report := d.FixDryRun(&CheckContext{TownRoot: "/test"})
+
+	if fixableCheck.fixCount != 0 || okCheck.fixCount != 0 {
+		t.Error("FixDryRun should never call Fix()")
+	}
+	if report.Checks[1].Status != StatusWarning {
+		t.Error("Fixable check should keep its status in a dry run")
+	}
+	if len(report.Checks[0].Plan) != 0 || len(report.Checks[2].Plan) != 0 {
+		t.Error("Only failing fixable checks should get a plan")
+	}
+	// mockCheck does not implement DryRunnable, so it gets a placeholder.
+	if len(report.Checks[1].Plan) != 1 || !strings.Contains(report.Checks[1].Plan[0], "no dry-run plan") {
+		t.Errorf("expected placeholder plan, got %v", report.Checks[1].Plan)
+	}
+}
+
 func TestBaseCheck(t *testing.T) {
 	b := &BaseCheck{
 		CheckName:        "test",
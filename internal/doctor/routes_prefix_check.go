@@ -0,0 +1,182 @@
+package doctor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+// RoutesPrefixCheck detects when a rig's config.json declares a different
+// beads prefix than the routes.jsonl entry for that rig. The rig config is
+// what gt uses when creating beads for the rig, while routes.jsonl decides
+// where bd sends an ID; when they disagree, newly created beads cannot be
+// routed back to the rig.
+//
+// Like PrefixMismatchCheck (rigs.json ↔ routes.jsonl), this check treats
+// routes.jsonl as the source of truth: existing beads were created under the
+// routed prefix, so the fix rewrites config.json to match it.
+type RoutesPrefixCheck struct {
+	FixableCheck
+	mismatches []routesPrefixMismatch // Cached during Run for use in Fix
+}
+
+type routesPrefixMismatch struct {
+	rigName      string
+	routePath    string
+	configPrefix string // From <rig>/config.json (without trailing hyphen)
+	routePrefix  string // From routes.jsonl (without trailing hyphen)
+}
+
+// NewRoutesPrefixCheck creates a new routes prefix check.
+func NewRoutesPrefixCheck() *RoutesPrefixCheck {
+	return &RoutesPrefixCheck{
+		FixableCheck: FixableCheck{
+			BaseCheck: BaseCheck{
+				CheckName:        "routes-prefix",
+				CheckDescription: "Check rig config.json prefixes match routes.jsonl",
+				CheckCategory:    CategoryConfig,
+			},
+		},
+	}
+}
+
+// Run compares each registered rig's config.json prefix with its route.
+func (c *RoutesPrefixCheck) Run(ctx *CheckContext) *CheckResult {
+	c.mismatches = nil
+
+	routes, err := beads.LoadRoutes(filepath.Join(ctx.TownRoot, ".beads"))
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("Could not load routes.jsonl: %v", err),
+		}
+	}
+	if len(routes) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No routes configured (nothing to check)",
+		}
+	}
+
+	rigsConfig, err := config.LoadRigsConfig(filepath.Join(ctx.TownRoot, "mayor", "rigs.json"))
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No rigs.json found (nothing to check)",
+		}
+	}
+
+	routePrefixByPath := make(map[string]string)
+	for _, r := range routes {
+		routePrefixByPath[r.Path] = strings.TrimSuffix(r.Prefix, "-")
+	}
+
+	rigNames := make([]string, 0, len(rigsConfig.Rigs))
+	for name := range rigsConfig.Rigs {
+		rigNames = append(rigNames, name)
+	}
+	sort.Strings(rigNames)
+
+	var details []string
+	for _, rigName := range rigNames {
+		rigCfg, err := rig.LoadRigConfig(filepath.Join(ctx.TownRoot, rigName))
+		if err != nil || rigCfg.Beads == nil || rigCfg.Beads.Prefix == "" {
+			// Missing or prefix-less config.json is rig-config-sync's concern.
+			continue
+		}
+		configPrefix := strings.TrimSuffix(rigCfg.Beads.Prefix, "-")
+
+		routePath := determineRigBeadsPath(ctx.TownRoot, rigName)
+		routePrefix, ok := routePrefixByPath[routePath]
+		if !ok {
+			// No route for this rig - routes-config check handles this
+			continue
+		}
+
+		if configPrefix != routePrefix {
+			c.mismatches = append(c.mismatches, routesPrefixMismatch{
+				rigName:      rigName,
+				routePath:    routePath,
+				configPrefix: configPrefix,
+				routePrefix:  routePrefix,
+			})
+			details = append(details, fmt.Sprintf("Rig '%s': config.json says '%s', routes.jsonl uses '%s'",
+				rigName, configPrefix, routePrefix))
+		}
+	}
+
+	if len(c.mismatches) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "Rig config prefixes match routes.jsonl",
+		}
+	}
+
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("%d prefix mismatch(es) between rig config.json and routes.jsonl", len(c.mismatches)),
+		Details: details,
+		FixHint: "Run 'gt doctor --fix' to update rig config.json with the routes.jsonl prefixes",
+	}
+}
+
+// Fix rewrites the beads prefix in each mismatched rig's config.json to the
+// prefix routes.jsonl uses. Other config.json fields are preserved as-is.
+func (c *RoutesPrefixCheck) Fix(ctx *CheckContext) error {
+	var failed []string
+	for _, m := range c.mismatches {
+		if err := setRigConfigPrefix(filepath.Join(ctx.TownRoot, m.rigName), m.routePrefix); err != nil {
+			failed = append(failed, fmt.Sprintf("%s (%v)", m.rigName, err))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("could not update config.json for: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// setRigConfigPrefix sets beads.prefix in a rig's config.json, leaving every
+// other field untouched.
+func setRigConfigPrefix(rigPath, prefix string) error {
+	configPath := filepath.Join(rigPath, "config.json")
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return err
+	}
+
+	var cfg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return fmt.Errorf("parsing %s: %w", configPath, err)
+	}
+	beadsCfg := make(map[string]json.RawMessage)
+	if raw, ok := cfg["beads"]; ok {
+		if err := json.Unmarshal(raw, &beadsCfg); err != nil {
+			return fmt.Errorf("parsing beads config in %s: %w", configPath, err)
+		}
+	}
+
+	if beadsCfg["prefix"], err = json.Marshal(prefix); err != nil {
+		return err
+	}
+	if cfg["beads"], err = json.Marshal(beadsCfg); err != nil {
+		return err
+	}
+
+	out, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(configPath, append(out, '\n'), 0644)
+}
@@ -0,0 +1,161 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// setupRoutesPrefixTown creates a town with the given rig config prefixes
+// (rig name -> config.json prefix) and routes.jsonl content.
+func setupRoutesPrefixTown(t *testing.T, rigPrefixes map[string]string, routes string) string {
+	t.Helper()
+	townRoot := t.TempDir()
+
+	mayorDir := filepath.Join(townRoot, "mayor")
+	if err := os.MkdirAll(mayorDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	var entries []string
+	for name, prefix := range rigPrefixes {
+		entries = append(entries, `"`+name+`": {"git_url": "https://example.com/`+name+`.git", "added_at": "2026-01-01T00:00:00Z"}`)
+
+		// Rig beads live in mayor/rig/.beads, reached via the rig's redirect.
+		rigDir := filepath.Join(townRoot, name)
+		if err := os.MkdirAll(filepath.Join(rigDir, "mayor", "rig", ".beads"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(filepath.Join(rigDir, ".beads"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(rigDir, ".beads", "redirect"), []byte("mayor/rig/.beads\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		cfg := `{"type": "rig", "version": 1, "name": "` + name + `", "beads": {"prefix": "` + prefix + `"}}`
+		if err := os.WriteFile(filepath.Join(rigDir, "config.json"), []byte(cfg), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rigsJSON := `{"version": 1, "rigs": {` + strings.Join(entries, ",") + `}}`
+	if err := os.WriteFile(filepath.Join(mayorDir, "rigs.json"), []byte(rigsJSON), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	beadsDir := filepath.Join(townRoot, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(beadsDir, "routes.jsonl"), []byte(routes), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return townRoot
+}
+
+func TestRoutesPrefixCheck_Matching(t *testing.T) {
+	townRoot := setupRoutesPrefixTown(t,
+		map[string]string{"gastown": "gt", "beads": "bd"},
+		`{"prefix": "hq-", "path": "."}
+{"prefix": "gt-", "path": "gastown/mayor/rig"}
+{"prefix": "bd-", "path": "beads/mayor/rig"}
+`)
+
+	check := NewRoutesPrefixCheck()
+	result := check.Run(&CheckContext{TownRoot: townRoot})
+	if result.Status != StatusOK {
+		t.Errorf("expected StatusOK, got %v: %s %v", result.Status, result.Message, result.Details)
+	}
+}
+
+func TestRoutesPrefixCheck_Mismatch(t *testing.T) {
+	townRoot := setupRoutesPrefixTown(t,
+		map[string]string{"gastown": "gt", "beads": "bd"},
+		`{"prefix": "hq-", "path": "."}
+{"prefix": "gas-", "path": "gastown/mayor/rig"}
+{"prefix": "bd-", "path": "beads/mayor/rig"}
+`)
+
+	check := NewRoutesPrefixCheck()
+	ctx := &CheckContext{TownRoot: townRoot}
+	result := check.Run(ctx)
+	if result.Status != StatusWarning {
+		t.Fatalf("expected StatusWarning, got %v: %s", result.Status, result.Message)
+	}
+	if len(result.Details) != 1 || !strings.Contains(result.Details[0], "gastown") ||
+		!strings.Contains(result.Details[0], "'gt'") || !strings.Contains(result.Details[0], "'gas'") {
+		t.Errorf("unexpected details: %v", result.Details)
+	}
+
+	if err := check.Fix(ctx); err != nil {
+		t.Fatalf("Fix: %v", err)
+	}
+
+	// routes.jsonl is the source of truth: config.json follows it.
+	cfg, err := loadRigConfigLocal(filepath.Join(townRoot, "gastown"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Beads == nil || cfg.Beads.Prefix != "gas" {
+		t.Errorf("gastown config.json prefix = %+v, want %q", cfg.Beads, "gas")
+	}
+	routes, err := beads.LoadRoutes(filepath.Join(townRoot, ".beads"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range routes {
+		if r.Path == "gastown/mayor/rig" && r.Prefix != "gas-" {
+			t.Errorf("routes.jsonl was rewritten: gastown prefix = %q", r.Prefix)
+		}
+	}
+
+	if result := check.Run(ctx); result.Status != StatusOK {
+		t.Errorf("expected StatusOK after fix, got %v: %v", result.Status, result.Details)
+	}
+}
+
+func TestRoutesPrefixCheck_FixPreservesOtherConfigFields(t *testing.T) {
+	townRoot := setupRoutesPrefixTown(t,
+		map[string]string{"gastown": "gt"},
+		`{"prefix": "gas-", "path": "gastown/mayor/rig"}
+`)
+	configPath := filepath.Join(townRoot, "gastown", "config.json")
+	cfg := `{"type": "rig", "name": "gastown", "push_remote": "staging", "beads": {"prefix": "gt", "repo": "local"}}`
+	if err := os.WriteFile(configPath, []byte(cfg), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	check := NewRoutesPrefixCheck()
+	ctx := &CheckContext{TownRoot: townRoot}
+	if result := check.Run(ctx); result.Status != StatusWarning {
+		t.Fatalf("expected StatusWarning, got %v: %s", result.Status, result.Message)
+	}
+	if err := check.Fix(ctx); err != nil {
+		t.Fatalf("Fix: %v", err)
+	}
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"push_remote": "staging"`, `"repo": "local"`, `"prefix": "gas"`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("config.json missing %s:\n%s", want, data)
+		}
+	}
+}
+
+func TestRoutesPrefixCheck_SkipsRigWithoutRoute(t *testing.T) {
+	// Missing routes are reported by the routes check, not this one.
+	townRoot := setupRoutesPrefixTown(t,
+		map[string]string{"gastown": "gt"},
+		`{"prefix": "hq-", "path": "."}
+`)
+
+	check := NewRoutesPrefixCheck()
+	result := check.Run(&CheckContext{TownRoot: townRoot})
+	if result.Status != StatusOK {
+		t.Errorf("expected StatusOK, got %v: %s %v", result.Status, result.Message, result.Details)
+	}
+}
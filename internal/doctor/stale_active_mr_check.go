@@ -0,0 +1,131 @@
+package doctor
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// activeMRStore is the subset of beads operations needed by StaleActiveMRCheck.
+// Allows injecting a fake store in tests without shelling out to bd.
+type activeMRStore interface {
+	ListAgentBeads() (map[string]*beads.Issue, error)
+	Show(id string) (*beads.Issue, error)
+	UpdateAgentActiveMR(id string, activeMR string) error
+}
+
+// StaleActiveMRCheck detects agent beads whose active_mr field points at a
+// merge-request bead that no longer exists. gt done sets active_mr when it
+// creates an MR; if the MR bead is later cleaned up, the agent bead keeps a
+// dangling reference that blocks polecat removal and misreports agent state.
+//
+// The fix clears active_mr on the affected agent beads.
+type StaleActiveMRCheck struct {
+	FixableCheck
+	newStore func(beadsPath string) activeMRStore // Injectable for testing; nil uses beads.New
+	stale    []staleActiveMR                      // Cached during Run for use in Fix
+}
+
+type staleActiveMR struct {
+	store   activeMRStore
+	agentID string
+	mrID    string
+}
+
+// NewStaleActiveMRCheck creates a new stale active_mr check.
+func NewStaleActiveMRCheck() *StaleActiveMRCheck {
+	return &StaleActiveMRCheck{
+		FixableCheck: FixableCheck{
+			BaseCheck: BaseCheck{
+				CheckName:        "stale-active-mr",
+				CheckDescription: "Detect agent beads whose active_mr points at a missing MR bead",
+				CheckCategory:    CategoryRig,
+			},
+		},
+	}
+}
+
+// Run scans agent beads in the town and rig databases for dangling active_mr
+// references.
+func (c *StaleActiveMRCheck) Run(ctx *CheckContext) *CheckResult {
+	c.stale = nil
+
+	newStore := c.newStore
+	if newStore == nil {
+		newStore = func(beadsPath string) activeMRStore { return beads.New(beadsPath) }
+	}
+
+	seen := make(map[string]bool)
+	var details []string
+	for _, beadsPath := range activeMRBeadsPaths(ctx.TownRoot) {
+		store := newStore(beadsPath)
+		agents, err := store.ListAgentBeads()
+		if err != nil {
+			continue
+		}
+		for id, issue := range agents {
+			if seen[id] || issue == nil {
+				continue
+			}
+			seen[id] = true
+
+			fields := beads.ParseAgentFields(issue.Description)
+			if fields == nil || fields.ActiveMR == "" {
+				continue
+			}
+			if _, err := store.Show(fields.ActiveMR); !errors.Is(err, beads.ErrNotFound) {
+				// Exists, or lookup failed for another reason — not provably stale.
+				continue
+			}
+			c.stale = append(c.stale, staleActiveMR{store: store, agentID: id, mrID: fields.ActiveMR})
+			details = append(details, fmt.Sprintf("%s: active_mr %s not found", id, fields.ActiveMR))
+		}
+	}
+
+	if len(c.stale) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No stale active_mr references",
+		}
+	}
+
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("%d agent bead(s) reference a missing MR", len(c.stale)),
+		Details: details,
+		FixHint: "Run 'gt doctor --fix' to clear stale active_mr references",
+	}
+}
+
+// Fix clears active_mr on the agent beads found by Run.
+func (c *StaleActiveMRCheck) Fix(ctx *CheckContext) error {
+	var errs []error
+	for _, s := range c.stale {
+		if err := s.store.UpdateAgentActiveMR(s.agentID, ""); err != nil {
+			errs = append(errs, fmt.Errorf("clearing active_mr on %s: %w", s.agentID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// activeMRBeadsPaths returns the town beads path followed by each rig's
+// beads path from routes.jsonl.
+func activeMRBeadsPaths(townRoot string) []string {
+	paths := []string{beads.GetTownBeadsPath(townRoot)}
+	routes, err := beads.LoadRoutes(filepath.Join(townRoot, ".beads"))
+	if err != nil {
+		return paths
+	}
+	for _, r := range routes {
+		parts := strings.Split(r.Path, "/")
+		if len(parts) >= 1 && parts[0] != "." {
+			paths = append(paths, filepath.Join(townRoot, r.Path))
+		}
+	}
+	return paths
+}
@@ -0,0 +1,106 @@
+package doctor
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// fakeActiveMRStore is an in-memory activeMRStore.
+type fakeActiveMRStore struct {
+	agents  map[string]*beads.Issue
+	mrs     map[string]bool
+	showErr error // returned for every Show when set
+	cleared []string
+}
+
+func (f *fakeActiveMRStore) ListAgentBeads() (map[string]*beads.Issue, error) {
+	return f.agents, nil
+}
+
+func (f *fakeActiveMRStore) Show(id string) (*beads.Issue, error) {
+	if f.showErr != nil {
+		return nil, f.showErr
+	}
+	if !f.mrs[id] {
+		return nil, beads.ErrNotFound
+	}
+	return &beads.Issue{ID: id}, nil
+}
+
+func (f *fakeActiveMRStore) UpdateAgentActiveMR(id string, activeMR string) error {
+	issue, ok := f.agents[id]
+	if !ok {
+		return fmt.Errorf("agent %s not found", id)
+	}
+	fields := beads.ParseAgentFields(issue.Description)
+	fields.ActiveMR = activeMR
+	issue.Description = beads.FormatAgentDescription(issue.Title, fields)
+	f.cleared = append(f.cleared, id)
+	return nil
+}
+
+func agentWithActiveMR(id, mrID string) *beads.Issue {
+	return &beads.Issue{
+		ID:          id,
+		Title:       id,
+		Description: beads.FormatAgentDescription(id, &beads.AgentFields{RoleType: "polecat", AgentState: "working", ActiveMR: mrID}),
+	}
+}
+
+func newFakeActiveMRCheck(store *fakeActiveMRStore) *StaleActiveMRCheck {
+	check := NewStaleActiveMRCheck()
+	check.newStore = func(string) activeMRStore { return store }
+	return check
+}
+
+func TestStaleActiveMRCheck_LiveAndDangling(t *testing.T) {
+	store := &fakeActiveMRStore{
+		agents: map[string]*beads.Issue{
+			"gt-gastown-polecat-nux":   agentWithActiveMR("gt-gastown-polecat-nux", "gt-mr-live"),
+			"gt-gastown-polecat-toast": agentWithActiveMR("gt-gastown-polecat-toast", "gt-mr-gone"),
+			"gt-gastown-polecat-slit":  agentWithActiveMR("gt-gastown-polecat-slit", ""),
+		},
+		mrs: map[string]bool{"gt-mr-live": true},
+	}
+	check := newFakeActiveMRCheck(store)
+	ctx := &CheckContext{TownRoot: t.TempDir()}
+
+	result := check.Run(ctx)
+	if result.Status != StatusWarning {
+		t.Fatalf("Status = %v, want warning: %s", result.Status, result.Message)
+	}
+	if len(result.Details) != 1 || !strings.Contains(result.Details[0], "gt-gastown-polecat-toast") {
+		t.Errorf("Details = %v, want only the dangling agent", result.Details)
+	}
+
+	if err := check.Fix(ctx); err != nil {
+		t.Fatalf("Fix: %v", err)
+	}
+	if len(store.cleared) != 1 || store.cleared[0] != "gt-gastown-polecat-toast" {
+		t.Errorf("cleared = %v, want [gt-gastown-polecat-toast]", store.cleared)
+	}
+	if got := beads.ParseAgentFields(store.agents["gt-gastown-polecat-nux"].Description).ActiveMR; got != "gt-mr-live" {
+		t.Errorf("live active_mr changed to %q", got)
+	}
+
+	if result := check.Run(ctx); result.Status != StatusOK {
+		t.Errorf("after Fix, Status = %v, want OK: %v", result.Status, result.Details)
+	}
+}
+
+func TestStaleActiveMRCheck_LookupErrorNotStale(t *testing.T) {
+	store := &fakeActiveMRStore{
+		agents: map[string]*beads.Issue{
+			"gt-gastown-polecat-nux": agentWithActiveMR("gt-gastown-polecat-nux", "gt-mr-1"),
+		},
+		showErr: fmt.Errorf("database unavailable"),
+	}
+	check := newFakeActiveMRCheck(store)
+
+	if result := check.Run(&CheckContext{TownRoot: t.TempDir()}); result.Status != StatusOK {
+		t.Errorf("Status = %v, want OK when MR lookup fails for other reasons", result.Status)
+	}
+}
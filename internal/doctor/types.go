@@ -88,6 +88,7 @@ type CheckResult struct {
 	Category string        // Category for grouping (e.g., CategoryCore)
 	Elapsed  time.Duration // How long the check took to run
 	Fixed    bool          // True if this check was auto-fixed
+	Plan     []string      // Changes Fix would make (set by a dry run)
 }
 
 // Check defines the interface for a health check.
@@ -109,6 +110,14 @@ type Check interface {
 	CanFix() bool
 }
 
+// DryRunnable is implemented by fixable checks that can describe what Fix
+// would change (files written, processes killed, beads closed) without doing
+// it. FixPlan is called after Run and reads the same cached state Fix would
+// use; it must not modify anything.
+type DryRunnable interface {
+	FixPlan(ctx *CheckContext) []string
+}
+
 // ReportSummary summarizes the results of all checks.
 type ReportSummary struct {
 	Total       int
@@ -390,6 +399,7 @@ func (r *Report) printWarningsSection(w io.Writer, issues []*CheckResult) {
 			if check.FixHint != "" {
 				_, _ = fmt.Fprintf(w, "        %s%s\n", ui.MutedStyle.Render(ui.TreeLast), check.FixHint)
 			}
+			printPlan(w, check.Plan)
 		}
 	}
 
@@ -403,6 +413,7 @@ func (r *Report) printWarningsSection(w io.Writer, issues []*CheckResult) {
 			if check.FixHint != "" {
 				_, _ = fmt.Fprintf(w, "        %s%s\n", ui.MutedStyle.Render(ui.TreeLast), check.FixHint)
 			}
+			printPlan(w, check.Plan)
 		}
 	}
 
@@ -422,3 +433,10 @@ func (r *Report) printWarningsSection(w io.Writer, issues []*CheckResult) {
 		_, _ = fmt.Fprintln(w, ui.RenderPass(ui.IconPass+" All remaining checks passed"))
 	}
 }
+
+// printPlan outputs the dry-run fix plan for a check, if any.
+func printPlan(w io.Writer, plan []string) {
+	for _, step := range plan {
+		_, _ = fmt.Fprintf(w, "        %s\n", ui.RenderMuted("would: "+step))
+	}
+}
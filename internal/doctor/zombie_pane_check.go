@@ -0,0 +1,197 @@
+package doctor
+
+import (
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// PaneInspector abstracts the tmux pane operations used by ZombiePaneCheck
+// so tests can supply a fake tmux.
+type PaneInspector interface {
+	ListSessions() ([]string, error)
+	ListPanes(session string) ([]tmux.PaneInfo, error)
+	GetEnvironment(session, key string) (string, error)
+	PaneRunsAgent(session string, pane tmux.PaneInfo) bool
+	RestartPane(pane string) error
+	KillPane(pane string) error
+}
+
+// zombiePane is a wedged pane found by ZombiePaneCheck.
+type zombiePane struct {
+	session string
+	pane    tmux.PaneInfo
+	agent   bool // pane is the session's declared agent pane (GT_PANE_ID)
+}
+
+// ZombiePaneCheck detects wedged panes inside live Gas Town sessions: panes
+// whose process has exited (kept by remain-on-exit), and agent panes where
+// the agent is gone but a shell is still running. ZombieSessionCheck only
+// looks at whole sessions, so a session with one wedged pane can still look
+// healthy there.
+type ZombiePaneCheck struct {
+	FixableCheck
+	inspector   PaneInspector
+	zombiePanes []zombiePane // Cached during Run for use in Fix
+}
+
+// NewZombiePaneCheck creates a new zombie pane check.
+func NewZombiePaneCheck() *ZombiePaneCheck {
+	return &ZombiePaneCheck{
+		FixableCheck: FixableCheck{
+			BaseCheck: BaseCheck{
+				CheckName:        "zombie-panes",
+				CheckDescription: "Detect tmux panes whose agent process is gone",
+				CheckCategory:    CategoryCleanup,
+			},
+		},
+	}
+}
+
+// NewZombiePaneCheckWithInspector creates a check with a custom pane inspector (for testing).
+func NewZombiePaneCheckWithInspector(inspector PaneInspector) *ZombiePaneCheck {
+	check := NewZombiePaneCheck()
+	check.inspector = inspector
+	return check
+}
+
+func (c *ZombiePaneCheck) getInspector() PaneInspector {
+	if c.inspector == nil {
+		return tmux.NewTmux()
+	}
+	return c.inspector
+}
+
+// Run checks the panes of every Gas Town session for zombies.
+func (c *ZombiePaneCheck) Run(ctx *CheckContext) *CheckResult {
+	t := c.getInspector()
+
+	sessions, err := t.ListSessions()
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: "Could not list tmux sessions",
+			Details: []string{err.Error()},
+		}
+	}
+
+	var zombies []zombiePane
+	var paneCount int
+
+	for _, sess := range sessions {
+		if sess == "" || !session.IsKnownSession(sess) {
+			continue
+		}
+
+		// Skip crew sessions - they are human-managed, and their panes
+		// may intentionally be idle shells.
+		if isCrewSession(sess) {
+			continue
+		}
+
+		found, total := findZombiePanes(t, sess)
+		zombies = append(zombies, found...)
+		paneCount += total
+	}
+
+	// Cache zombies for Fix
+	c.zombiePanes = zombies
+
+	if len(zombies) == 0 {
+		msg := "No zombie panes found"
+		if paneCount > 0 {
+			msg = fmt.Sprintf("All %d Gas Town panes are healthy", paneCount)
+		}
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: msg,
+		}
+	}
+
+	details := make([]string, len(zombies))
+	for i, z := range zombies {
+		if z.pane.Dead {
+			details[i] = fmt.Sprintf("Zombie pane: %s %s (process exited)", z.session, z.pane.ID)
+		} else {
+			details[i] = fmt.Sprintf("Zombie pane: %s %s (agent gone, %s still running)", z.session, z.pane.ID, z.pane.Command)
+		}
+	}
+
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("Found %d zombie pane(s)", len(zombies)),
+		Details: details,
+		FixHint: "Run 'gt doctor --fix' to respawn agent panes and close dead panes",
+	}
+}
+
+// findZombiePanes returns the zombie panes of a session and how many panes
+// it has. A pane is a zombie if its process has exited, or if it is the
+// declared agent pane and the agent is not running in it. Sessions without a
+// declared agent pane only have dead panes flagged; ZombieSessionCheck covers
+// their agent.
+func findZombiePanes(t PaneInspector, sess string) ([]zombiePane, int) {
+	panes, err := t.ListPanes(sess)
+	if err != nil {
+		return nil, 0
+	}
+
+	agentPane, _ := t.GetEnvironment(sess, "GT_PANE_ID")
+
+	var zombies []zombiePane
+	for _, p := range panes {
+		isAgent := agentPane != "" && p.ID == agentPane
+		if p.Dead || (isAgent && !t.PaneRunsAgent(sess, p)) {
+			zombies = append(zombies, zombiePane{session: sess, pane: p, agent: isAgent})
+		}
+	}
+	return zombies, len(panes)
+}
+
+// Fix respawns zombie agent panes with their original command and closes
+// other dead panes. Each pane is re-checked first, so a pane that recovered
+// or disappeared since Run is left alone.
+func (c *ZombiePaneCheck) Fix(ctx *CheckContext) error {
+	if len(c.zombiePanes) == 0 {
+		return nil
+	}
+
+	t := c.getInspector()
+	var lastErr error
+
+	for _, z := range c.zombiePanes {
+		// SAFEGUARD: Never touch crew sessions (double-check)
+		if isCrewSession(z.session) {
+			continue
+		}
+
+		// TOCTOU guard: only act on panes that are still zombies.
+		current, _ := findZombiePanes(t, z.session)
+		still := false
+		for _, cz := range current {
+			if cz.pane.ID == z.pane.ID {
+				still = true
+				break
+			}
+		}
+		if !still {
+			continue
+		}
+
+		var err error
+		if z.agent {
+			err = t.RestartPane(z.pane.ID)
+		} else {
+			err = t.KillPane(z.pane.ID)
+		}
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
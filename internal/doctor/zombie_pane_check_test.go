@@ -0,0 +1,185 @@
+package doctor
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// fakePaneTmux is a fake tmux for ZombiePaneCheck. An agent runs in a pane
+// when the pane's command is in agentCommands.
+type fakePaneTmux struct {
+	sessions      []string
+	panes         map[string][]tmux.PaneInfo
+	agentPanes    map[string]string // session -> GT_PANE_ID
+	agentCommands []string
+	restarted     []string
+	killed        []string
+}
+
+func (f *fakePaneTmux) ListSessions() ([]string, error) { return f.sessions, nil }
+
+func (f *fakePaneTmux) ListPanes(session string) ([]tmux.PaneInfo, error) {
+	return f.panes[session], nil
+}
+
+func (f *fakePaneTmux) GetEnvironment(session, key string) (string, error) {
+	if key == "GT_PANE_ID" {
+		return f.agentPanes[session], nil
+	}
+	return "", nil
+}
+
+func (f *fakePaneTmux) PaneRunsAgent(session string, pane tmux.PaneInfo) bool {
+	return !pane.Dead && slices.Contains(f.agentCommands, pane.Command)
+}
+
+func (f *fakePaneTmux) RestartPane(pane string) error {
+	f.restarted = append(f.restarted, pane)
+	return nil
+}
+
+func (f *fakePaneTmux) KillPane(pane string) error {
+	f.killed = append(f.killed, pane)
+	return nil
+}
+
+func newFakePaneTmux() *fakePaneTmux {
+	return &fakePaneTmux{
+		sessions: []string{"gt-witness", "gt-nux", "gt-toast", "gt-crew-joe", "personal"},
+		panes: map[string][]tmux.PaneInfo{
+			// Healthy: agent running in the declared pane, plus a user shell.
+			"gt-witness": {{ID: "%1", Command: "claude"}, {ID: "%2", Command: "bash"}},
+			// Agent died, leaving a live shell in the agent pane.
+			"gt-nux": {{ID: "%3", Command: "bash"}},
+			// Agent healthy, but an auxiliary pane's process exited.
+			"gt-toast": {{ID: "%4", Command: "claude"}, {ID: "%5", Command: "make", Dead: true}},
+			// Crew and non-Gas Town sessions are never inspected.
+			"gt-crew-joe": {{ID: "%6", Command: "bash"}},
+			"personal":    {{ID: "%7", Command: "vim", Dead: true}},
+		},
+		agentPanes: map[string]string{
+			"gt-witness":  "%1",
+			"gt-nux":      "%3",
+			"gt-toast":    "%4",
+			"gt-crew-joe": "%6",
+		},
+		agentCommands: []string{"claude", "node"},
+	}
+}
+
+func TestNewZombiePaneCheck(t *testing.T) {
+	check := NewZombiePaneCheck()
+
+	if check.Name() != "zombie-panes" {
+		t.Errorf("expected name 'zombie-panes', got %q", check.Name())
+	}
+	if !check.CanFix() {
+		t.Error("expected CanFix to return true")
+	}
+	if check.Category() != CategoryCleanup {
+		t.Errorf("expected category %q, got %q", CategoryCleanup, check.Category())
+	}
+}
+
+func TestZombiePaneCheck_DetectsDeadPanes(t *testing.T) {
+	setupTestRegistry(t)
+	fake := newFakePaneTmux()
+	check := NewZombiePaneCheckWithInspector(fake)
+
+	result := check.Run(&CheckContext{TownRoot: t.TempDir()})
+
+	if result.Status != StatusWarning {
+		t.Fatalf("Status = %v, want warning: %s", result.Status, result.Message)
+	}
+	if result.Message != "Found 2 zombie pane(s)" {
+		t.Errorf("Message = %q, want 2 zombie panes", result.Message)
+	}
+	joined := strings.Join(result.Details, "\n")
+	for _, want := range []string{"gt-nux %3 (agent gone, bash still running)", "gt-toast %5 (process exited)"} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("Details missing %q:\n%s", want, joined)
+		}
+	}
+	for _, unwanted := range []string{"gt-witness", "gt-crew-joe", "personal"} {
+		if strings.Contains(joined, unwanted) {
+			t.Errorf("Details should not mention %s:\n%s", unwanted, joined)
+		}
+	}
+}
+
+func TestZombiePaneCheck_Healthy(t *testing.T) {
+	setupTestRegistry(t)
+	fake := &fakePaneTmux{
+		sessions:      []string{"gt-witness"},
+		panes:         map[string][]tmux.PaneInfo{"gt-witness": {{ID: "%1", Command: "claude"}, {ID: "%2", Command: "bash"}}},
+		agentPanes:    map[string]string{"gt-witness": "%1"},
+		agentCommands: []string{"claude"},
+	}
+	check := NewZombiePaneCheckWithInspector(fake)
+
+	result := check.Run(&CheckContext{TownRoot: t.TempDir()})
+
+	if result.Status != StatusOK {
+		t.Errorf("Status = %v, want OK: %s %v", result.Status, result.Message, result.Details)
+	}
+}
+
+func TestZombiePaneCheck_LegacySessionOnlyFlagsDeadPanes(t *testing.T) {
+	setupTestRegistry(t)
+	fake := &fakePaneTmux{
+		sessions: []string{"gt-nux"},
+		panes: map[string][]tmux.PaneInfo{
+			"gt-nux": {{ID: "%1", Command: "bash"}, {ID: "%2", Command: "tail", Dead: true}},
+		},
+		agentCommands: []string{"claude"},
+	}
+	check := NewZombiePaneCheckWithInspector(fake)
+
+	result := check.Run(&CheckContext{TownRoot: t.TempDir()})
+
+	if len(result.Details) != 1 || !strings.Contains(result.Details[0], "%2 (process exited)") {
+		t.Errorf("Details = %v, want only the dead pane %%2", result.Details)
+	}
+}
+
+func TestZombiePaneCheck_FixRespawnsAgentAndKillsDeadPanes(t *testing.T) {
+	setupTestRegistry(t)
+	fake := newFakePaneTmux()
+	check := NewZombiePaneCheckWithInspector(fake)
+
+	check.Run(&CheckContext{TownRoot: t.TempDir()})
+	if err := check.Fix(&CheckContext{TownRoot: t.TempDir()}); err != nil {
+		t.Fatalf("Fix: %v", err)
+	}
+
+	if !slices.Equal(fake.restarted, []string{"%3"}) {
+		t.Errorf("restarted = %v, want [%%3] (agent pane)", fake.restarted)
+	}
+	if !slices.Equal(fake.killed, []string{"%5"}) {
+		t.Errorf("killed = %v, want [%%5] (dead auxiliary pane)", fake.killed)
+	}
+}
+
+func TestZombiePaneCheck_FixSkipsRecoveredPanes(t *testing.T) {
+	setupTestRegistry(t)
+	fake := newFakePaneTmux()
+	check := NewZombiePaneCheckWithInspector(fake)
+
+	check.Run(&CheckContext{TownRoot: t.TempDir()})
+
+	// The agent came back in gt-nux between Run and Fix.
+	fake.panes["gt-nux"] = []tmux.PaneInfo{{ID: "%3", Command: "claude"}}
+
+	if err := check.Fix(&CheckContext{TownRoot: t.TempDir()}); err != nil {
+		t.Fatalf("Fix: %v", err)
+	}
+	if len(fake.restarted) != 0 {
+		t.Errorf("restarted = %v, want none after recovery", fake.restarted)
+	}
+	if !slices.Equal(fake.killed, []string{"%5"}) {
+		t.Errorf("killed = %v, want [%%5]", fake.killed)
+	}
+}
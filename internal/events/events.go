@@ -69,6 +69,9 @@ const (
 	TypeMergeFailed  = "merge_failed"
 	TypeMergeSkipped = "merge_skipped"
 
+	// Convoy events
+	TypeConvoyClosed = "convoy_closed"
+
 	// Scheduler events
 	TypeSchedulerEnqueue        = "scheduler_enqueue"         // Bead scheduled for deferred dispatch
 	TypeSchedulerDispatch       = "scheduler_dispatch"        // Bead dispatched from scheduler
@@ -184,6 +187,16 @@ func DonePayload(beadID, branch string) map[string]interface{} {
 	}
 }
 
+// ConvoyClosedPayload creates a payload for convoy close events.
+func ConvoyClosedPayload(convoyID, title, reason string, forced bool) map[string]interface{} {
+	return map[string]interface{}{
+		"convoy": convoyID,
+		"title":  title,
+		"reason": reason,
+		"forced": forced,
+	}
+}
+
 // MailPayload creates a payload for mail events.
 func MailPayload(to, subject string) map[string]interface{} {
 	return map[string]interface{}{
@@ -0,0 +1,74 @@
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"time"
+)
+
+// NotableTypes are the event types Summarize lists individually, in
+// addition to counting them: completed work, escalations, failed merges,
+// closed convoys, and mass session deaths.
+var NotableTypes = map[string]bool{
+	TypeDone:           true,
+	TypeEscalationSent: true,
+	TypeMergeFailed:    true,
+	TypeConvoyClosed:   true,
+	TypeMassDeath:      true,
+}
+
+// EventSummary counts the events logged since a cutoff.
+type EventSummary struct {
+	Since time.Time `json:"since"`
+	Total int       `json:"total"`
+
+	// Counts maps event type to the number of events of that type.
+	Counts map[string]int `json:"counts"`
+
+	// Notable lists events of NotableTypes in log order.
+	Notable []Event `json:"notable,omitempty"`
+}
+
+// maxEventLine bounds a single event line read by Summarize.
+const maxEventLine = 1 << 20
+
+// Summarize reads the events log at path and summarizes the events with a
+// timestamp at or after since. Lines that are not valid event JSON, or whose
+// timestamp cannot be parsed, are skipped. A missing log is an empty summary.
+func Summarize(path string, since time.Time) (*EventSummary, error) {
+	summary := &EventSummary{Since: since, Counts: make(map[string]int)}
+
+	f, err := os.Open(path) //nolint:gosec // G304: path is the town events log
+	if errors.Is(err, fs.ErrNotExist) {
+		return summary, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxEventLine)
+	for scanner.Scan() {
+		var e Event
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		ts, err := time.Parse(time.RFC3339, e.Timestamp)
+		if err != nil || ts.Before(since) {
+			continue
+		}
+		summary.Total++
+		summary.Counts[e.Type]++
+		if NotableTypes[e.Type] {
+			summary.Notable = append(summary.Notable, e)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
@@ -0,0 +1,94 @@
+package events
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const summaryFixture = `{"ts":"2026-01-01T08:00:00Z","source":"gt","type":"sling","actor":"mayor","visibility":"feed"}
+{"ts":"2026-01-01T08:59:59Z","source":"gt","type":"done","actor":"gastown/nux","payload":{"bead":"gt-old"},"visibility":"feed"}
+{"ts":"2026-01-01T09:00:00Z","source":"gt","type":"sling","actor":"mayor","payload":{"bead":"gt-a"},"visibility":"feed"}
+not json
+{"ts":"2026-01-01T10:00:00Z","source":"gt","type":"done","actor":"gastown/nux","payload":{"bead":"gt-a"},"visibility":"feed"}
+{"ts":"yesterday","source":"gt","type":"done","actor":"gastown/nux","visibility":"feed"}
+{"ts":"2026-01-01T11:00:00Z","source":"gt","type":"nudge","actor":"deacon","visibility":"feed"}
+{"ts":"2026-01-01T12:00:00Z","source":"gt","type":"escalation_sent","actor":"gastown/witness","payload":{"reason":"stuck"},"visibility":"feed"}
+{"ts":"2026-01-01T13:00:00Z","source":"gt","type":"sling","actor":"mayor","payload":{"bead":"gt-b"},"visibility":"feed"}
+`
+
+func writeSummaryFixture(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), EventsFile)
+	if err := os.WriteFile(path, []byte(summaryFixture), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestSummarize(t *testing.T) {
+	path := writeSummaryFixture(t)
+	since := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	s, err := Summarize(path, since)
+	if err != nil {
+		t.Fatalf("Summarize: %v", err)
+	}
+
+	if s.Total != 5 {
+		t.Errorf("Total = %d, want 5", s.Total)
+	}
+	want := map[string]int{"sling": 2, "done": 1, "nudge": 1, "escalation_sent": 1}
+	for typ, n := range want {
+		if s.Counts[typ] != n {
+			t.Errorf("Counts[%s] = %d, want %d", typ, s.Counts[typ], n)
+		}
+	}
+	if len(s.Counts) != len(want) {
+		t.Errorf("Counts = %v, want %v", s.Counts, want)
+	}
+
+	if len(s.Notable) != 2 || s.Notable[0].Type != TypeDone || s.Notable[1].Type != TypeEscalationSent {
+		t.Fatalf("Notable = %+v, want done then escalation_sent", s.Notable)
+	}
+	if s.Notable[0].Payload["bead"] != "gt-a" {
+		t.Errorf("notable done bead = %v, want gt-a (gt-old is before the cutoff)", s.Notable[0].Payload["bead"])
+	}
+}
+
+func TestSummarize_Cutoff(t *testing.T) {
+	path := writeSummaryFixture(t)
+
+	tests := []struct {
+		name  string
+		since time.Time
+		total int
+	}{
+		{"before everything", time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC), 7},
+		{"cutoff is inclusive", time.Date(2026, 1, 1, 13, 0, 0, 0, time.UTC), 1},
+		{"cutoff in another zone", time.Date(2026, 1, 1, 7, 0, 0, 0, time.FixedZone("EST", -5*3600)), 2},
+		{"after everything", time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Summarize(path, tt.since)
+			if err != nil {
+				t.Fatalf("Summarize: %v", err)
+			}
+			if s.Total != tt.total {
+				t.Errorf("Total = %d, want %d", s.Total, tt.total)
+			}
+		})
+	}
+}
+
+func TestSummarize_MissingLog(t *testing.T) {
+	s, err := Summarize(filepath.Join(t.TempDir(), EventsFile), time.Time{})
+	if err != nil {
+		t.Fatalf("Summarize: %v", err)
+	}
+	if s.Total != 0 || len(s.Counts) != 0 {
+		t.Errorf("summary = %+v, want empty", s)
+	}
+}
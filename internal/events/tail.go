@@ -0,0 +1,99 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"time"
+)
+
+// DefaultFollowInterval is how often Follow polls the events log for
+// appended lines.
+const DefaultFollowInterval = 200 * time.Millisecond
+
+// Follow tails the events log at path like tail -f: it starts at the current
+// end of the file and calls fn for each event appended afterwards, until ctx
+// is done. Lines that are not valid event JSON are skipped, and a partially
+// written line is held until its newline arrives. If the file does not exist
+// yet, Follow waits for it; if it shrinks (pruned by gt krc), reading
+// restarts from the beginning.
+func Follow(ctx context.Context, path string, interval time.Duration, fn func(Event)) error {
+	if interval <= 0 {
+		interval = DefaultFollowInterval
+	}
+
+	var offset int64
+	if info, err := os.Stat(path); err == nil {
+		offset = info.Size()
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	var pending []byte
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		info, err := os.Stat(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			offset, pending = 0, nil
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if info.Size() < offset {
+			offset, pending = 0, nil
+		}
+		if info.Size() == offset {
+			continue
+		}
+
+		data, err := readFrom(path, offset)
+		if err != nil {
+			return err
+		}
+		offset += int64(len(data))
+		pending = append(pending, data...)
+
+		for {
+			i := bytes.IndexByte(pending, '\n')
+			if i < 0 {
+				break
+			}
+			line := bytes.TrimSpace(pending[:i])
+			pending = pending[i+1:]
+			if len(line) == 0 {
+				continue
+			}
+			var event Event
+			if err := json.Unmarshal(line, &event); err != nil {
+				continue
+			}
+			fn(event)
+		}
+	}
+}
+
+// readFrom returns the contents of path from offset to the current end.
+func readFrom(path string, offset int64) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return io.ReadAll(f)
+}
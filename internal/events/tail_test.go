@@ -0,0 +1,130 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// eventCollector gathers events delivered by Follow.
+type eventCollector struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (c *eventCollector) add(e Event) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.events = append(c.events, e)
+}
+
+func (c *eventCollector) types() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var out []string
+	for _, e := range c.events {
+		out = append(out, e.Type)
+	}
+	return out
+}
+
+// waitFor polls until the collector has n events or the deadline passes.
+func (c *eventCollector) waitFor(t *testing.T, n int) []string {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if got := c.types(); len(got) >= n {
+			return got
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d events, got %v", n, c.types())
+	return nil
+}
+
+func appendRaw(t *testing.T, path, data string) {
+	t.Helper()
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func eventLine(t *testing.T, eventType string) string {
+	t.Helper()
+	data, err := json.Marshal(Event{Timestamp: time.Now().UTC().Format(time.RFC3339), Type: eventType, Actor: "gastown/nux"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data) + "\n"
+}
+
+func startFollow(t *testing.T, path string) *eventCollector {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	c := &eventCollector{}
+	go func() { done <- Follow(ctx, path, 5*time.Millisecond, c.add) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("Follow: %v", err)
+		}
+	})
+	// Give Follow a moment to record the starting offset.
+	time.Sleep(20 * time.Millisecond)
+	return c
+}
+
+func TestFollow_DeliversOnlyAppendedEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), EventsFile)
+	appendRaw(t, path, eventLine(t, "old"))
+
+	c := startFollow(t, path)
+	appendRaw(t, path, eventLine(t, TypeSling)+"not json\n"+eventLine(t, TypeDone))
+
+	got := c.waitFor(t, 2)
+	if len(got) != 2 || got[0] != TypeSling || got[1] != TypeDone {
+		t.Errorf("events = %v, want [sling done] (existing and malformed lines skipped)", got)
+	}
+}
+
+func TestFollow_HoldsPartialLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), EventsFile)
+	c := startFollow(t, path) // file does not exist yet
+
+	line := eventLine(t, TypeMerged)
+	appendRaw(t, path, line[:10])
+	time.Sleep(30 * time.Millisecond)
+	if got := c.types(); len(got) != 0 {
+		t.Fatalf("partial line delivered early: %v", got)
+	}
+	appendRaw(t, path, line[10:])
+
+	if got := c.waitFor(t, 1); got[0] != TypeMerged {
+		t.Errorf("events = %v, want [merged]", got)
+	}
+}
+
+func TestFollow_RestartsAfterTruncation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), EventsFile)
+	appendRaw(t, path, eventLine(t, "old")+eventLine(t, "old"))
+	c := startFollow(t, path)
+
+	// Pruning rewrites the log shorter than the offset Follow holds.
+	if err := os.WriteFile(path, []byte(eventLine(t, TypeKill)), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := c.waitFor(t, 1); got[0] != TypeKill {
+		t.Errorf("events = %v, want [kill]", got)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
 )
 
@@ -83,6 +84,12 @@ func (g *Git) IsRepo() bool {
 	return err == nil
 }
 
+// Toplevel returns the absolute path of the working tree root containing
+// workDir (git rev-parse --show-toplevel).
+func (g *Git) Toplevel() (string, error) {
+	return g.run("rev-parse", "--show-toplevel")
+}
+
 // run executes a git command and returns stdout.
 func (g *Git) run(args ...string) (string, error) {
 	// If gitDir is set (bare repo), prepend --git-dir flag
@@ -606,6 +613,17 @@ func (g *Git) RemoteDefaultBranch() string {
 	return "main" // final fallback
 }
 
+// RemoteHEADBranch returns the branch refs/remotes/<remote>/HEAD points at.
+// Unlike RemoteDefaultBranch it does not guess: it returns an error when the
+// symbolic ref is not set.
+func (g *Git) RemoteHEADBranch(remote string) (string, error) {
+	out, err := g.run("symbolic-ref", "refs/remotes/"+remote+"/HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(out, "refs/remotes/"+remote+"/"), nil
+}
+
 // HasUncommittedChanges returns true if there are uncommitted changes.
 func (g *Git) HasUncommittedChanges() (bool, error) {
 	status, err := g.Status()
@@ -615,6 +633,16 @@ func (g *Git) HasUncommittedChanges() (bool, error) {
 	return !status.Clean, nil
 }
 
+// HasTrackedChanges returns true if tracked files have staged or unstaged
+// changes. Untracked files are ignored.
+func (g *Git) HasTrackedChanges() (bool, error) {
+	out, err := g.run("status", "--porcelain", "--untracked-files=no")
+	if err != nil {
+		return false, err
+	}
+	return out != "", nil
+}
+
 // RemoteURL returns the URL for the given remote.
 func (g *Git) RemoteURL(remote string) (string, error) {
 	return g.run("remote", "get-url", remote)
@@ -1037,6 +1065,28 @@ func (g *Git) ResetHard(ref string) error {
 	return err
 }
 
+// ResetSoft moves HEAD to ref, keeping the index and working tree.
+func (g *Git) ResetSoft(ref string) error {
+	_, err := g.run("reset", "--soft", ref)
+	return err
+}
+
+// RemoteBranchesContaining returns the remote-tracking branches (e.g.
+// "origin/main") whose history includes commit.
+func (g *Git) RemoteBranchesContaining(commit string) ([]string, error) {
+	out, err := g.run("branch", "-r", "--contains", commit, "--format=%(refname:short)")
+	if err != nil {
+		return nil, err
+	}
+	var branches []string
+	for _, line := range strings.Split(out, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasSuffix(line, "/HEAD") {
+			branches = append(branches, line)
+		}
+	}
+	return branches, nil
+}
+
 // Rev returns the commit hash for the given ref.
 func (g *Git) Rev(ref string) (string, error) {
 	return g.run("rev-parse", ref)
@@ -1317,6 +1367,147 @@ func (g *Git) CommitsAhead(base, branch string) (int, error) {
 	return count, nil
 }
 
+// DiffStat summarizes the changes between two refs.
+type DiffStat struct {
+	Files      int // Files changed
+	Insertions int // Lines added
+	Deletions  int // Lines removed
+}
+
+// String formats the stat as "N files changed, +I -D".
+func (s DiffStat) String() string {
+	noun := "files"
+	if s.Files == 1 {
+		noun = "file"
+	}
+	return fmt.Sprintf("%d %s changed, +%d -%d", s.Files, noun, s.Insertions, s.Deletions)
+}
+
+// DiffStat returns the changes on branch since it diverged from base
+// (git diff --shortstat base...branch).
+func (g *Git) DiffStat(base, branch string) (DiffStat, error) {
+	out, err := g.run("diff", "--shortstat", base+"..."+branch)
+	if err != nil {
+		return DiffStat{}, err
+	}
+	return parseShortStat(out), nil
+}
+
+// parseShortStat parses git's --shortstat line, e.g.
+// " 3 files changed, 10 insertions(+), 2 deletions(-)". Either count may be
+// absent; empty output means no changes.
+func parseShortStat(out string) DiffStat {
+	var stat DiffStat
+	for _, part := range strings.Split(strings.TrimSpace(out), ",") {
+		fields := strings.Fields(part)
+		if len(fields) < 2 {
+			continue
+		}
+		n, err := strconv.Atoi(fields[0])
+		if err != nil {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(fields[1], "file"):
+			stat.Files = n
+		case strings.HasPrefix(fields[1], "insertion"):
+			stat.Insertions = n
+		case strings.HasPrefix(fields[1], "deletion"):
+			stat.Deletions = n
+		}
+	}
+	return stat
+}
+
+// GeneratedPathPatterns are file name patterns (matched against the base
+// name with filepath.Match) that MeaningfulDiff treats as generated.
+var GeneratedPathPatterns = []string{
+	"go.sum",
+	"package-lock.json",
+	"yarn.lock",
+	"pnpm-lock.yaml",
+	"Cargo.lock",
+	"poetry.lock",
+	"*.pb.go",
+	"*_generated.go",
+	"*.gen.go",
+	"zz_generated*",
+	"*.min.js",
+	"*.min.css",
+}
+
+// generatedDirs are path components whose contents are always generated or
+// vendored.
+var generatedDirs = []string{"vendor", "node_modules"}
+
+// isGeneratedPath reports whether path matches GeneratedPathPatterns or lies
+// under a generated directory.
+func isGeneratedPath(path string) bool {
+	base := filepath.Base(path)
+	for _, pattern := range GeneratedPathPatterns {
+		if ok, _ := filepath.Match(pattern, base); ok {
+			return true
+		}
+	}
+	for _, part := range strings.Split(filepath.ToSlash(filepath.Dir(path)), "/") {
+		for _, dir := range generatedDirs {
+			if part == dir {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasGeneratedHeader reports whether content starts with a generated-code
+// marker ("Code generated ... DO NOT EDIT.") within its first few lines.
+func hasGeneratedHeader(content string) bool {
+	lines := strings.SplitN(content, "\n", 6)
+	if len(lines) > 5 {
+		lines = lines[:5]
+	}
+	for _, line := range lines {
+		if strings.Contains(line, "Code generated") && strings.Contains(line, "DO NOT EDIT") {
+			return true
+		}
+	}
+	return false
+}
+
+// MeaningfulDiff reports whether branch has substantive changes since it
+// diverged from base: changes that remain after ignoring whitespace and
+// blank lines, in files that are not generated (see GeneratedPathPatterns
+// and generated-code headers). Binary and deleted files count as
+// substantive unless their path is generated.
+func (g *Git) MeaningfulDiff(base, branch string) (bool, error) {
+	// -z keeps paths verbatim; without it git C-quotes unusual names.
+	out, err := g.run("diff", "--numstat", "-z", "--no-renames", "-w", "--ignore-blank-lines", "--ignore-cr-at-eol", base+"..."+branch)
+	if err != nil {
+		return false, err
+	}
+
+	for _, record := range strings.Split(out, "\x00") {
+		fields := strings.SplitN(record, "\t", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		if fields[0] == "0" && fields[1] == "0" {
+			continue
+		}
+		path := fields[2]
+		if isGeneratedPath(path) {
+			continue
+		}
+		// Deleted files have no content on branch; a deletion is substantive.
+		content, err := g.run("show", branch+":"+path)
+		if err == nil && hasGeneratedHeader(content) {
+			continue
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
 // CountCommitsBehind returns the number of commits that HEAD is behind the given ref.
 // For example, CountCommitsBehind("origin/main") returns how many commits
 // are on origin/main that are not on the current HEAD.
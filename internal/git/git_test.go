@@ -61,6 +61,110 @@ func TestIsRepo(t *testing.T) {
 	}
 }
 
+func TestToplevel(t *testing.T) {
+	dir := initTestRepo(t)
+	nested := filepath.Join(dir, "a", "b")
+	if err := os.MkdirAll(nested, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := NewGit(nested).Toplevel()
+	if err != nil {
+		t.Fatalf("Toplevel: %v", err)
+	}
+	want, _ := filepath.EvalSymlinks(dir)
+	if gotReal, _ := filepath.EvalSymlinks(got); gotReal != want {
+		t.Errorf("Toplevel() = %q, want %q", got, want)
+	}
+
+	if _, err := NewGit(t.TempDir()).Toplevel(); err == nil {
+		t.Error("expected error outside a git repo")
+	}
+}
+
+func TestRemoteHEADBranch(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	if _, err := g.RemoteHEADBranch("origin"); err == nil {
+		t.Error("expected error when origin/HEAD is not set")
+	}
+
+	cmd := exec.Command("git", "symbolic-ref", "refs/remotes/origin/HEAD", "refs/remotes/origin/release/v2")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("symbolic-ref: %v\n%s", err, out)
+	}
+	got, err := g.RemoteHEADBranch("origin")
+	if err != nil || got != "release/v2" {
+		t.Errorf("RemoteHEADBranch() = %q, %v; want release/v2", got, err)
+	}
+}
+
+func TestDiffStat(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+	base, err := g.CurrentBranch()
+	if err != nil {
+		t.Fatalf("CurrentBranch: %v", err)
+	}
+
+	if err := g.CreateBranch("feature"); err != nil {
+		t.Fatalf("CreateBranch: %v", err)
+	}
+	if err := g.Checkout("feature"); err != nil {
+		t.Fatalf("Checkout: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("# Changed\nmore\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "new.txt"), []byte("a\nb\nc\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Add("."); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := g.Commit("feature work"); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+
+	stat, err := g.DiffStat(base, "feature")
+	if err != nil {
+		t.Fatalf("DiffStat: %v", err)
+	}
+	want := DiffStat{Files: 2, Insertions: 5, Deletions: 1}
+	if stat != want {
+		t.Errorf("DiffStat = %+v, want %+v", stat, want)
+	}
+	if got := stat.String(); got != "2 files changed, +5 -1" {
+		t.Errorf("String() = %q", got)
+	}
+
+	if stat, err := g.DiffStat("feature", "feature"); err != nil || stat != (DiffStat{}) {
+		t.Errorf("DiffStat(same) = %+v, %v; want zero", stat, err)
+	}
+	if _, err := g.DiffStat(base, "no-such-branch"); err == nil {
+		t.Error("expected error for unknown branch")
+	}
+}
+
+func TestParseShortStat(t *testing.T) {
+	tests := []struct {
+		in   string
+		want DiffStat
+	}{
+		{"", DiffStat{}},
+		{" 1 file changed, 1 insertion(+)", DiffStat{Files: 1, Insertions: 1}},
+		{" 2 files changed, 3 deletions(-)", DiffStat{Files: 2, Deletions: 3}},
+		{" 12 files changed, 340 insertions(+), 56 deletions(-)\n", DiffStat{Files: 12, Insertions: 340, Deletions: 56}},
+	}
+	for _, tt := range tests {
+		if got := parseShortStat(tt.in); got != tt.want {
+			t.Errorf("parseShortStat(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
 func TestCloneWithReferenceCreatesAlternates(t *testing.T) {
 	tmp := t.TempDir()
 	src := filepath.Join(tmp, "src")
@@ -767,6 +871,36 @@ func initTestRepoWithRemote(t *testing.T) (string, string, string) {
 	return localDir, remoteDir, mainBranch
 }
 
+func TestRemoteBranchesContaining(t *testing.T) {
+	localDir, _, mainBranch := initTestRepoWithRemote(t)
+	g := NewGit(localDir)
+
+	got, err := g.RemoteBranchesContaining("HEAD")
+	if err != nil {
+		t.Fatalf("RemoteBranchesContaining: %v", err)
+	}
+	if len(got) != 1 || got[0] != "origin/"+mainBranch {
+		t.Errorf("RemoteBranchesContaining(HEAD) = %v, want [origin/%s]", got, mainBranch)
+	}
+
+	if err := os.WriteFile(filepath.Join(localDir, "new.txt"), []byte("x\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Add("new.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Commit("local only"); err != nil {
+		t.Fatal(err)
+	}
+	got, err = g.RemoteBranchesContaining("HEAD")
+	if err != nil {
+		t.Fatalf("RemoteBranchesContaining: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("unpushed commit reported on remotes: %v", got)
+	}
+}
+
 func TestPruneStaleBranches_MergedBranch(t *testing.T) {
 	localDir, _, mainBranch := initTestRepoWithRemote(t)
 	g := NewGit(localDir)
@@ -1758,3 +1892,115 @@ func TestCheckBranchContamination(t *testing.T) {
 		t.Errorf("Ahead (from main) = %d, want 5", contam.Ahead)
 	}
 }
+
+func TestMeaningfulDiff(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string // path -> content on the feature branch ("" deletes)
+		want  bool
+	}{
+		{
+			name:  "whitespace only",
+			files: map[string]string{"main.go": "package main\n\nfunc  main()  {\n\n\n}\n"},
+			want:  false,
+		},
+		{
+			name:  "generated files only",
+			files: map[string]string{"go.sum": "example.com/x v1.0.0 h1:abc=\n", "api/api.pb.go": "package api\n"},
+			want:  false,
+		},
+		{
+			name:  "generated header",
+			files: map[string]string{"zz.go": "// Code generated by stringer; DO NOT EDIT.\n\npackage main\n"},
+			want:  false,
+		},
+		{
+			name:  "generated header in quoted path",
+			files: map[string]string{"gen \"ü\"\t.go": "// Code generated by stringer; DO NOT EDIT.\n\npackage main\n"},
+			want:  false,
+		},
+		{
+			name:  "vendored",
+			files: map[string]string{"vendor/example.com/x/x.go": "package x\n"},
+			want:  false,
+		},
+		{
+			name:  "substantive change",
+			files: map[string]string{"main.go": "package main\n\nfunc main() {\n\tprintln(\"hi\")\n}\n"},
+			want:  true,
+		},
+		{
+			name:  "substantive among whitespace churn",
+			files: map[string]string{"README.md": "#  Test\n", "new.txt": "real content\n"},
+			want:  true,
+		},
+		{
+			name:  "deletion",
+			files: map[string]string{"main.go": ""},
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := initTestRepo(t)
+			g := NewGit(dir)
+			if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n\nfunc main() {\n}\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if err := g.Add("."); err != nil {
+				t.Fatalf("Add: %v", err)
+			}
+			if err := g.Commit("add main"); err != nil {
+				t.Fatalf("Commit: %v", err)
+			}
+			base, err := g.CurrentBranch()
+			if err != nil {
+				t.Fatalf("CurrentBranch: %v", err)
+			}
+
+			if err := g.CreateBranch("feature"); err != nil {
+				t.Fatalf("CreateBranch: %v", err)
+			}
+			if err := g.Checkout("feature"); err != nil {
+				t.Fatalf("Checkout: %v", err)
+			}
+			for path, content := range tt.files {
+				full := filepath.Join(dir, path)
+				if content == "" {
+					if err := os.Remove(full); err != nil {
+						t.Fatal(err)
+					}
+					continue
+				}
+				if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(full, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := g.Add("-A"); err != nil {
+				t.Fatalf("Add: %v", err)
+			}
+			if err := g.Commit("feature work"); err != nil {
+				t.Fatalf("Commit: %v", err)
+			}
+
+			got, err := g.MeaningfulDiff(base, "feature")
+			if err != nil {
+				t.Fatalf("MeaningfulDiff: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("MeaningfulDiff = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMeaningfulDiff_UnknownBranch(t *testing.T) {
+	g := NewGit(initTestRepo(t))
+	if _, err := g.MeaningfulDiff("HEAD", "no-such-branch"); err == nil {
+		t.Error("expected error for unknown branch")
+	}
+}
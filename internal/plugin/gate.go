@@ -0,0 +1,100 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// DefaultConditionTimeout bounds a condition gate's check command when the
+// plugin does not set [execution] timeout.
+const DefaultConditionTimeout = 30 * time.Second
+
+// conditionWaitDelay is how long to wait for the check's output pipes to
+// close after the process is killed. Without it, a grandchild that inherited
+// the pipes (e.g. `sh -c "sleep 600"`) keeps Wait blocked past the timeout.
+const conditionWaitDelay = 2 * time.Second
+
+// GateResult is the outcome of evaluating a plugin gate.
+type GateResult struct {
+	// Open is true when the gate allows the plugin to run.
+	Open bool `json:"open"`
+
+	// Reason explains why the gate is closed (empty when open).
+	Reason string `json:"reason,omitempty"`
+}
+
+// ConditionTimeout returns the time limit for running this plugin's
+// condition check: the [execution] timeout if set and valid, otherwise
+// DefaultConditionTimeout.
+func (p *Plugin) ConditionTimeout() time.Duration {
+	if p.Execution != nil && p.Execution.Timeout != "" {
+		if d, err := time.ParseDuration(p.Execution.Timeout); err == nil && d > 0 {
+			return d
+		}
+	}
+	return DefaultConditionTimeout
+}
+
+// EvaluateCondition runs a condition gate's check command in the plugin
+// directory and reports whether the gate is open (exit 0).
+//
+// The check is bounded by ConditionTimeout so a hanging command cannot stall
+// the caller; a timeout is treated as a closed gate with a recorded reason.
+// Plugins without a condition gate are reported as open.
+func EvaluateCondition(ctx context.Context, p *Plugin) GateResult {
+	if p.Gate == nil || p.Gate.Type != GateCondition {
+		return GateResult{Open: true}
+	}
+	check := strings.TrimSpace(p.Gate.Check)
+	if check == "" {
+		return GateResult{Reason: "condition gate has no check command"}
+	}
+
+	timeout := p.ConditionTimeout()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", check) //nolint:gosec // G204: check comes from trusted plugin.md
+	cmd.Dir = p.Path
+	cmd.WaitDelay = conditionWaitDelay
+
+	err := cmd.Run()
+	if err == nil {
+		return GateResult{Open: true}
+	}
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return GateResult{Reason: fmt.Sprintf("check timed out after %s", timeout)}
+	}
+	if ctx.Err() != nil {
+		return GateResult{Reason: "check cancelled"}
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return GateResult{Reason: fmt.Sprintf("check exited %d", exitErr.ExitCode())}
+	}
+	return GateResult{Reason: fmt.Sprintf("check failed: %v", err)}
+}
+
+// ShouldRunOnEvent reports whether an event-gated plugin should run when the
+// named event fires. Gate.On may list several events separated by commas
+// (e.g., "startup, handoff"); matching ignores surrounding space and case.
+// Plugins without an event gate never match.
+func ShouldRunOnEvent(p *Plugin, eventName string) bool {
+	if p == nil || p.Gate == nil || p.Gate.Type != GateEvent {
+		return false
+	}
+	eventName = strings.TrimSpace(eventName)
+	if eventName == "" {
+		return false
+	}
+	for _, on := range strings.Split(p.Gate.On, ",") {
+		if strings.EqualFold(strings.TrimSpace(on), eventName) {
+			return true
+		}
+	}
+	return false
+}
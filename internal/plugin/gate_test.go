@@ -0,0 +1,135 @@
+package plugin
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func conditionPlugin(t *testing.T, check, timeout string) *Plugin {
+	t.Helper()
+	p := &Plugin{
+		Name: "cond",
+		Path: t.TempDir(),
+		Gate: &Gate{Type: GateCondition, Check: check},
+	}
+	if timeout != "" {
+		p.Execution = &Execution{Timeout: timeout}
+	}
+	return p
+}
+
+func TestEvaluateCondition_Passes(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+	result := EvaluateCondition(context.Background(), conditionPlugin(t, "exit 0", ""))
+	if !result.Open {
+		t.Errorf("expected gate open, got closed: %s", result.Reason)
+	}
+}
+
+func TestEvaluateCondition_Fails(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+	result := EvaluateCondition(context.Background(), conditionPlugin(t, "exit 3", ""))
+	if result.Open {
+		t.Fatal("expected gate closed for non-zero exit")
+	}
+	if result.Reason != "check exited 3" {
+		t.Errorf("Reason = %q, want %q", result.Reason, "check exited 3")
+	}
+}
+
+func TestEvaluateCondition_TimesOut(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+	start := time.Now()
+	result := EvaluateCondition(context.Background(), conditionPlugin(t, "sleep 30", "200ms"))
+	elapsed := time.Since(start)
+
+	if result.Open {
+		t.Fatal("expected gate closed on timeout")
+	}
+	if !strings.Contains(result.Reason, "timed out after 200ms") {
+		t.Errorf("Reason = %q, want timeout reason", result.Reason)
+	}
+	if elapsed > 10*time.Second {
+		t.Errorf("EvaluateCondition took %s, timeout not enforced", elapsed)
+	}
+}
+
+func TestEvaluateCondition_RunsInPluginDir(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+	p := conditionPlugin(t, `test "$(pwd -P)" = "$(cd "$PLUGIN_DIR" && pwd -P)"`, "")
+	t.Setenv("PLUGIN_DIR", p.Path)
+	if result := EvaluateCondition(context.Background(), p); !result.Open {
+		t.Errorf("expected check to run in plugin dir: %s", result.Reason)
+	}
+}
+
+func TestEvaluateCondition_NonConditionGate(t *testing.T) {
+	p := &Plugin{Gate: &Gate{Type: GateCooldown, Duration: "1h"}}
+	if result := EvaluateCondition(context.Background(), p); !result.Open {
+		t.Errorf("non-condition gate should be open, got %q", result.Reason)
+	}
+}
+
+func TestConditionTimeout(t *testing.T) {
+	tests := []struct {
+		name string
+		exec *Execution
+		want time.Duration
+	}{
+		{"no execution", nil, DefaultConditionTimeout},
+		{"empty timeout", &Execution{}, DefaultConditionTimeout},
+		{"invalid timeout", &Execution{Timeout: "soon"}, DefaultConditionTimeout},
+		{"custom timeout", &Execution{Timeout: "5s"}, 5 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Plugin{Execution: tt.exec}
+			if got := p.ConditionTimeout(); got != tt.want {
+				t.Errorf("ConditionTimeout() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShouldRunOnEvent(t *testing.T) {
+	eventPlugin := func(on string) *Plugin {
+		return &Plugin{Gate: &Gate{Type: GateEvent, On: on}}
+	}
+	tests := []struct {
+		name   string
+		plugin *Plugin
+		event  string
+		want   bool
+	}{
+		{"single match", eventPlugin("startup"), "startup", true},
+		{"single no match", eventPlugin("startup"), "shutdown", false},
+		{"multiple first", eventPlugin("startup,handoff"), "startup", true},
+		{"multiple with spaces", eventPlugin("startup, handoff , done"), "handoff", true},
+		{"multiple no match", eventPlugin("startup,handoff"), "done", false},
+		{"case insensitive", eventPlugin("Startup"), "startup", true},
+		{"no partial match", eventPlugin("startup"), "start", false},
+		{"empty event", eventPlugin("startup"), "", false},
+		{"empty on", eventPlugin(""), "startup", false},
+		{"cooldown gate", &Plugin{Gate: &Gate{Type: GateCooldown, On: "startup"}}, "startup", false},
+		{"no gate", &Plugin{}, "startup", false},
+		{"nil plugin", nil, "startup", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ShouldRunOnEvent(tt.plugin, tt.event); got != tt.want {
+				t.Errorf("ShouldRunOnEvent(%q) = %v, want %v", tt.event, got, tt.want)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 type Scanner struct {
 	townRoot string
 	rigNames []string
+	shadowed []*Plugin // Plugins overridden during the last DiscoverAll
 }
 
 // NewScanner creates a new plugin scanner.
@@ -25,9 +26,11 @@ func NewScanner(townRoot string, rigNames []string) *Scanner {
 
 // DiscoverAll scans all plugin locations and returns discovered plugins.
 // Town-level plugins are scanned first, then rig-level plugins.
-// Plugins are deduplicated by name (rig-level overrides town-level).
+// Plugins are deduplicated by name (rig-level overrides town-level); the
+// overridden plugins are available from Shadowed afterwards.
 func (s *Scanner) DiscoverAll() ([]*Plugin, error) {
 	pluginMap := make(map[string]*Plugin)
+	s.shadowed = nil
 
 	// Scan town-level plugins first
 	townPlugins, err := s.scanTownPlugins()
@@ -47,6 +50,10 @@ func (s *Scanner) DiscoverAll() ([]*Plugin, error) {
 			continue
 		}
 		for _, p := range rigPlugins {
+			if prev, ok := pluginMap[p.Name]; ok {
+				prev.ShadowedBy = p.Origin()
+				s.shadowed = append(s.shadowed, prev)
+			}
 			pluginMap[p.Name] = p
 		}
 	}
@@ -60,6 +67,12 @@ func (s *Scanner) DiscoverAll() ([]*Plugin, error) {
 	return plugins, nil
 }
 
+// Shadowed returns the plugins that the last DiscoverAll dropped because a
+// rig-level plugin with the same name overrides them. Each has ShadowedBy set.
+func (s *Scanner) Shadowed() []*Plugin {
+	return s.shadowed
+}
+
 // scanTownPlugins scans the town-level plugins directory.
 func (s *Scanner) scanTownPlugins() ([]*Plugin, error) {
 	pluginsDir := filepath.Join(s.townRoot, "plugins")
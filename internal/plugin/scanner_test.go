@@ -731,6 +731,58 @@ func TestPlugin_ExecWrapperSummary(t *testing.T) {
 	}
 }
 
+func TestScanner_DiscoverAll_Shadowing(t *testing.T) {
+	tmpDir := t.TempDir()
+	writePlugin := func(dir, name, desc string) {
+		t.Helper()
+		pluginDir := filepath.Join(dir, name)
+		if err := os.MkdirAll(pluginDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		content := "+++\nname = \"" + name + "\"\ndescription = \"" + desc + "\"\nversion = 1\n+++\n"
+		if err := os.WriteFile(filepath.Join(pluginDir, "plugin.md"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writePlugin(filepath.Join(tmpDir, "plugins"), "rebuild-gt", "town version")
+	writePlugin(filepath.Join(tmpDir, "plugins"), "town-only", "not shadowed")
+	writePlugin(filepath.Join(tmpDir, "gastown", "plugins"), "rebuild-gt", "rig version")
+
+	scanner := NewScanner(tmpDir, []string{"gastown"})
+	plugins, err := scanner.DiscoverAll()
+	if err != nil {
+		t.Fatalf("DiscoverAll: %v", err)
+	}
+	if len(plugins) != 2 {
+		t.Fatalf("expected 2 plugins, got %d", len(plugins))
+	}
+	for _, p := range plugins {
+		if p.Name == "rebuild-gt" && p.Location != LocationRig {
+			t.Errorf("rebuild-gt should resolve to the rig plugin, got %s", p.Location)
+		}
+		if p.ShadowedBy != "" {
+			t.Errorf("active plugin %s has ShadowedBy %q", p.Name, p.ShadowedBy)
+		}
+	}
+
+	shadowed := scanner.Shadowed()
+	if len(shadowed) != 1 {
+		t.Fatalf("expected 1 shadowed plugin, got %d", len(shadowed))
+	}
+	if got := shadowed[0]; got.Name != "rebuild-gt" || got.Location != LocationTown || got.ShadowedBy != "rig gastown" {
+		t.Errorf("shadowed = %s/%s by %q, want town rebuild-gt by \"rig gastown\"", got.Location, got.Name, got.ShadowedBy)
+	}
+
+	// A rescan without the rig plugin clears the previous result.
+	scanner.rigNames = nil
+	if _, err := scanner.DiscoverAll(); err != nil {
+		t.Fatal(err)
+	}
+	if got := scanner.Shadowed(); len(got) != 0 {
+		t.Errorf("Shadowed after rescan = %d plugins, want 0", len(got))
+	}
+}
+
 func TestScanner_DiscoverExecWrappers(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "plugin-wrapper-test")
 	if err != nil {
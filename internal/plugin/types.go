@@ -49,6 +49,10 @@ type Plugin struct {
 	// When true, FormatMailBody instructs the dog to execute the script
 	// instead of interpreting the markdown instructions.
 	HasRunScript bool `json:"has_run_script,omitempty"`
+
+	// ShadowedBy is set on a plugin that DiscoverAll dropped because a
+	// later-scanned plugin with the same name overrides it (e.g. "rig gastown").
+	ShadowedBy string `json:"shadowed_by,omitempty"`
 }
 
 // Location indicates where a plugin was discovered.
@@ -182,6 +186,14 @@ type PluginSummary struct {
 	Path          string        `json:"path"`
 }
 
+// Origin describes where the plugin was discovered: "town" or "rig <name>".
+func (p *Plugin) Origin() string {
+	if p.Location == LocationRig {
+		return "rig " + p.RigName
+	}
+	return string(p.Location)
+}
+
 // Summary returns a PluginSummary for this plugin.
 func (p *Plugin) Summary() PluginSummary {
 	var gateType GateType
@@ -0,0 +1,282 @@
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Problem describes a single validation failure found by Scanner.Check.
+type Problem struct {
+	// Plugin is the plugin name, or the directory name when the
+	// frontmatter could not be parsed.
+	Plugin string `json:"plugin"`
+
+	// Location indicates where the plugin was discovered.
+	Location Location `json:"location"`
+
+	// RigName is set for rig-level plugins (empty for town-level).
+	RigName string `json:"rig_name,omitempty"`
+
+	// Path is the absolute path to the plugin directory.
+	Path string `json:"path"`
+
+	// Message describes what is wrong.
+	Message string `json:"message"`
+}
+
+// CheckResult is the outcome of validating every plugin in a town.
+type CheckResult struct {
+	// Checked is the number of plugin directories examined.
+	Checked int `json:"checked"`
+
+	// Problems lists every validation failure found.
+	Problems []Problem `json:"problems"`
+}
+
+// OK reports whether no problems were found.
+func (r *CheckResult) OK() bool {
+	return len(r.Problems) == 0
+}
+
+// Check scans all plugin locations and validates every plugin it finds.
+// Unlike DiscoverAll, plugins that fail to parse are reported as problems
+// instead of being skipped, and shadowed town-level plugins are validated
+// too, so every problem is reported in a single pass.
+func (s *Scanner) Check() (*CheckResult, error) {
+	result := &CheckResult{Problems: []Problem{}}
+
+	if err := s.checkDirectory(result, filepath.Join(s.townRoot, "plugins"), LocationTown, ""); err != nil {
+		return nil, fmt.Errorf("checking town plugins: %w", err)
+	}
+	for _, rigName := range s.rigNames {
+		dir := filepath.Join(s.townRoot, rigName, "plugins")
+		if err := s.checkDirectory(result, dir, LocationRig, rigName); err != nil {
+			return nil, fmt.Errorf("checking plugins for rig %q: %w", rigName, err)
+		}
+	}
+
+	return result, nil
+}
+
+// checkDirectory validates each plugin directory under dir, appending
+// problems to result.
+func (s *Scanner) checkDirectory(result *CheckResult, dir string, location Location, rigName string) error {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil // No plugins directory is fine
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		pluginDir := filepath.Join(dir, entry.Name())
+		problem := Problem{
+			Plugin:   entry.Name(),
+			Location: location,
+			RigName:  rigName,
+			Path:     pluginDir,
+		}
+
+		p, err := s.loadPlugin(pluginDir, location, rigName)
+		if err != nil {
+			result.Checked++
+			problem.Message = err.Error()
+			result.Problems = append(result.Problems, problem)
+			continue
+		}
+		if p == nil {
+			continue // No plugin.md, not a plugin
+		}
+
+		result.Checked++
+		problem.Plugin = p.Name
+		for _, msg := range Validate(p) {
+			problem.Message = msg
+			result.Problems = append(result.Problems, problem)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks a parsed plugin for semantic errors that parsing alone
+// does not catch: unknown gate or execution types, missing or malformed
+// gate fields, bad durations, and references to files that don't exist.
+// Returns one message per problem; nil means the plugin is valid.
+func Validate(p *Plugin) []string {
+	var problems []string
+
+	if p.Gate != nil {
+		problems = append(problems, validateGate(p.Gate)...)
+	}
+
+	if p.Execution != nil {
+		switch p.Execution.Type {
+		case "", ExecTypeAgent:
+		case ExecTypeScript:
+			if !p.HasRunScript {
+				problems = append(problems, "execution type \"script\" requires a run.sh alongside plugin.md")
+			}
+		case ExecTypeExecWrapper:
+			if len(p.Execution.Wrapper) == 0 {
+				problems = append(problems, "execution type \"exec-wrapper\" requires a non-empty wrapper")
+			}
+		default:
+			problems = append(problems, fmt.Sprintf("unknown execution type %q", p.Execution.Type))
+		}
+
+		if p.Execution.Timeout != "" {
+			if d, err := time.ParseDuration(p.Execution.Timeout); err != nil {
+				problems = append(problems, fmt.Sprintf("invalid execution timeout %q: %v", p.Execution.Timeout, err))
+			} else if d <= 0 {
+				problems = append(problems, fmt.Sprintf("execution timeout %q must be positive", p.Execution.Timeout))
+			}
+		}
+	}
+
+	return problems
+}
+
+// validateGate checks that a gate's type is known and that the fields
+// required by that type are present and well-formed.
+func validateGate(g *Gate) []string {
+	var problems []string
+
+	switch g.Type {
+	case GateCooldown:
+		if g.Duration == "" {
+			problems = append(problems, "cooldown gate requires a duration")
+		} else if d, err := time.ParseDuration(g.Duration); err != nil {
+			problems = append(problems, fmt.Sprintf("invalid cooldown duration %q: %v", g.Duration, err))
+		} else if d <= 0 {
+			problems = append(problems, fmt.Sprintf("cooldown duration %q must be positive", g.Duration))
+		}
+	case GateCron:
+		if g.Schedule == "" {
+			problems = append(problems, "cron gate requires a schedule")
+		} else if err := validateCronSchedule(g.Schedule); err != nil {
+			problems = append(problems, fmt.Sprintf("invalid cron schedule %q: %v", g.Schedule, err))
+		}
+	case GateCondition:
+		if strings.TrimSpace(g.Check) == "" {
+			problems = append(problems, "condition gate requires a check command")
+		}
+	case GateEvent:
+		if strings.TrimSpace(g.On) == "" {
+			problems = append(problems, "event gate requires an \"on\" event")
+		}
+	case GateManual:
+	case "":
+		problems = append(problems, "gate section present but type is empty")
+	default:
+		problems = append(problems, fmt.Sprintf("unknown gate type %q", g.Type))
+	}
+
+	return problems
+}
+
+// cronDescriptors are the predefined schedule shorthands accepted in place
+// of a five-field expression.
+var cronDescriptors = map[string]bool{
+	"@yearly":   true,
+	"@annually": true,
+	"@monthly":  true,
+	"@weekly":   true,
+	"@daily":    true,
+	"@midnight": true,
+	"@hourly":   true,
+}
+
+// cronFieldRanges are the inclusive bounds for minute, hour, day of month,
+// month, and day of week (0 and 7 are both Sunday).
+var cronFieldRanges = [5]struct {
+	name     string
+	min, max int
+}{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+// validateCronSchedule checks a standard five-field cron expression.
+// Each field may be "*", a number, a range "a-b", a step "*/n" or "a-b/n",
+// or a comma-separated list of those.
+func validateCronSchedule(schedule string) error {
+	schedule = strings.TrimSpace(schedule)
+	if strings.HasPrefix(schedule, "@") {
+		if cronDescriptors[schedule] {
+			return nil
+		}
+		return fmt.Errorf("unknown descriptor %s", schedule)
+	}
+
+	fields := strings.Fields(schedule)
+	if len(fields) != len(cronFieldRanges) {
+		return fmt.Errorf("expected %d fields, got %d", len(cronFieldRanges), len(fields))
+	}
+
+	for i, field := range fields {
+		r := cronFieldRanges[i]
+		for _, part := range strings.Split(field, ",") {
+			if err := validateCronPart(part, r.min, r.max); err != nil {
+				return fmt.Errorf("%s field %q: %w", r.name, field, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateCronPart checks a single list element of a cron field.
+func validateCronPart(part string, min, max int) error {
+	rangePart, step, hasStep := strings.Cut(part, "/")
+	if hasStep {
+		n, err := strconv.Atoi(step)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid step %q", step)
+		}
+	}
+
+	if rangePart == "*" {
+		return nil
+	}
+
+	lo, hi, isRange := strings.Cut(rangePart, "-")
+	start, err := parseCronValue(lo, min, max)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return nil
+	}
+	end, err := parseCronValue(hi, min, max)
+	if err != nil {
+		return err
+	}
+	if start > end {
+		return fmt.Errorf("range %s is backwards", rangePart)
+	}
+	return nil
+}
+
+// parseCronValue parses a single numeric cron value within [min, max].
+func parseCronValue(s string, min, max int) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if n < min || n > max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", n, min, max)
+	}
+	return n, nil
+}
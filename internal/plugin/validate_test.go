@@ -0,0 +1,157 @@
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeTestPlugin(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	pluginDir := filepath.Join(dir, name)
+	if err := os.MkdirAll(pluginDir, 0755); err != nil {
+		t.Fatalf("failed to create plugin dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(pluginDir, "plugin.md"), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write plugin.md: %v", err)
+	}
+	return pluginDir
+}
+
+func TestScanner_Check(t *testing.T) {
+	tmpDir := t.TempDir()
+	townPlugins := filepath.Join(tmpDir, "plugins")
+	rigPlugins := filepath.Join(tmpDir, "testrig", "plugins")
+
+	writeTestPlugin(t, townPlugins, "good-cooldown", `+++
+name = "good-cooldown"
+[gate]
+type = "cooldown"
+duration = "1h"
+[execution]
+timeout = "5m"
++++
+`)
+	writeTestPlugin(t, townPlugins, "good-cron", `+++
+name = "good-cron"
+[gate]
+type = "cron"
+schedule = "*/15 9-17 * * 1-5"
++++
+`)
+	writeTestPlugin(t, townPlugins, "bad-toml", `+++
+name = "bad-toml
++++
+`)
+	writeTestPlugin(t, townPlugins, "bad-cron", `+++
+name = "bad-cron"
+[gate]
+type = "cron"
+schedule = "0 25 * * *"
++++
+`)
+	writeTestPlugin(t, rigPlugins, "bad-cooldown", `+++
+name = "bad-cooldown"
+[gate]
+type = "cooldown"
+duration = "1 day"
++++
+`)
+	writeTestPlugin(t, rigPlugins, "missing-script", `+++
+name = "missing-script"
+[execution]
+type = "script"
++++
+`)
+	// A directory without plugin.md is not a plugin and must not be counted.
+	if err := os.MkdirAll(filepath.Join(townPlugins, "not-a-plugin"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := NewScanner(tmpDir, []string{"testrig"}).Check()
+	if err != nil {
+		t.Fatalf("Check failed: %v", err)
+	}
+
+	if result.Checked != 6 {
+		t.Errorf("Checked = %d, want 6", result.Checked)
+	}
+	if result.OK() {
+		t.Fatal("expected problems, got none")
+	}
+
+	byPlugin := make(map[string]Problem)
+	for _, p := range result.Problems {
+		byPlugin[p.Plugin] = p
+	}
+	for _, name := range []string{"bad-toml", "bad-cron", "bad-cooldown", "missing-script"} {
+		if _, ok := byPlugin[name]; !ok {
+			t.Errorf("expected a problem for %s, got %+v", name, result.Problems)
+		}
+	}
+	for _, name := range []string{"good-cooldown", "good-cron"} {
+		if p, ok := byPlugin[name]; ok {
+			t.Errorf("unexpected problem for %s: %s", name, p.Message)
+		}
+	}
+	if got := byPlugin["bad-cooldown"]; got.RigName != "testrig" || got.Location != LocationRig {
+		t.Errorf("bad-cooldown location = %s/%s, want rig/testrig", got.Location, got.RigName)
+	}
+}
+
+func TestScanner_Check_NoPluginDirs(t *testing.T) {
+	result, err := NewScanner(t.TempDir(), []string{"norig"}).Check()
+	if err != nil {
+		t.Fatalf("Check failed: %v", err)
+	}
+	if !result.OK() || result.Checked != 0 {
+		t.Errorf("expected empty OK result, got %+v", result)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		plugin  Plugin
+		wantErr string // substring; empty means valid
+	}{
+		{"no gate", Plugin{}, ""},
+		{"manual", Plugin{Gate: &Gate{Type: GateManual}}, ""},
+		{"cooldown ok", Plugin{Gate: &Gate{Type: GateCooldown, Duration: "30m"}}, ""},
+		{"cooldown missing duration", Plugin{Gate: &Gate{Type: GateCooldown}}, "requires a duration"},
+		{"cooldown negative", Plugin{Gate: &Gate{Type: GateCooldown, Duration: "-1h"}}, "must be positive"},
+		{"cron descriptor", Plugin{Gate: &Gate{Type: GateCron, Schedule: "@daily"}}, ""},
+		{"cron list", Plugin{Gate: &Gate{Type: GateCron, Schedule: "0,30 9 * * 0,7"}}, ""},
+		{"cron too few fields", Plugin{Gate: &Gate{Type: GateCron, Schedule: "0 9 *"}}, "expected 5 fields"},
+		{"cron backwards range", Plugin{Gate: &Gate{Type: GateCron, Schedule: "0 17-9 * * *"}}, "backwards"},
+		{"cron bad step", Plugin{Gate: &Gate{Type: GateCron, Schedule: "*/0 * * * *"}}, "invalid step"},
+		{"cron unknown descriptor", Plugin{Gate: &Gate{Type: GateCron, Schedule: "@fortnightly"}}, "unknown descriptor"},
+		{"condition missing check", Plugin{Gate: &Gate{Type: GateCondition}}, "check command"},
+		{"event missing on", Plugin{Gate: &Gate{Type: GateEvent}}, "\"on\" event"},
+		{"empty gate type", Plugin{Gate: &Gate{}}, "type is empty"},
+		{"unknown gate type", Plugin{Gate: &Gate{Type: "sometimes"}}, "unknown gate type"},
+		{"bad timeout", Plugin{Execution: &Execution{Timeout: "soon"}}, "invalid execution timeout"},
+		{"unknown exec type", Plugin{Execution: &Execution{Type: "daemon"}}, "unknown execution type"},
+		{"script with run.sh", Plugin{HasRunScript: true, Execution: &Execution{Type: ExecTypeScript}}, ""},
+		{"wrapper missing tokens", Plugin{Execution: &Execution{Type: ExecTypeExecWrapper}}, "non-empty wrapper"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems := Validate(&tt.plugin)
+			if tt.wantErr == "" {
+				if len(problems) != 0 {
+					t.Errorf("expected valid, got %v", problems)
+				}
+				return
+			}
+			if len(problems) == 0 {
+				t.Fatalf("expected problem containing %q, got none", tt.wantErr)
+			}
+			if !strings.Contains(strings.Join(problems, "; "), tt.wantErr) {
+				t.Errorf("problems %v do not contain %q", problems, tt.wantErr)
+			}
+		})
+	}
+}
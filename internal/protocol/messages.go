@@ -490,6 +490,39 @@ func ParseReworkRequestPayload(body string) (*ReworkRequestPayload, error) {
 	return payload, nil
 }
 
+// FormatPolecatDoneBody formats a completion record in the key-value form
+// ParsePolecatDonePayload reads, so mail about a finished polecat (such as
+// gt done's dispatcher notification) can be parsed by automated dispatchers.
+// The polecat name is carried in the subject, not the body. Empty optional
+// fields are omitted.
+func FormatPolecatDoneBody(p PolecatDonePayload) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Exit: %s\n", p.ExitType))
+	if p.Issue != "" {
+		sb.WriteString(fmt.Sprintf("Issue: %s\n", p.Issue))
+	}
+	sb.WriteString(fmt.Sprintf("Branch: %s\n", p.Branch))
+	if p.MR != "" {
+		sb.WriteString(fmt.Sprintf("MR: %s\n", p.MR))
+	}
+	if p.ConvoyID != "" {
+		sb.WriteString(fmt.Sprintf("ConvoyID: %s\n", p.ConvoyID))
+	}
+	if p.ConvoyOwned {
+		sb.WriteString("ConvoyOwned: true\n")
+	}
+	if p.MergeStrategy != "" {
+		sb.WriteString(fmt.Sprintf("MergeStrategy: %s\n", p.MergeStrategy))
+	}
+	if p.Errors != "" {
+		sb.WriteString(fmt.Sprintf("Errors: %s\n", p.Errors))
+	}
+	if p.PRURL != "" {
+		sb.WriteString(fmt.Sprintf("PR: %s\n", p.PRURL))
+	}
+	return sb.String()
+}
+
 // ParsePolecatDonePayload parses a POLECAT_DONE notification body.
 // Unlike formal protocol messages, POLECAT_DONE is a mail convention — no
 // required fields are enforced. Returns a best-effort parse of available fields.
@@ -503,6 +536,7 @@ func ParsePolecatDonePayload(polecatName, body string) *PolecatDonePayload {
 		ConvoyID:      parseField(body, "ConvoyID"),
 		MergeStrategy: parseField(body, "MergeStrategy"),
 		Errors:        parseField(body, "Errors"),
+		PRURL:         parseField(body, "PR"),
 	}
 
 	if parseField(body, "ConvoyOwned") == "true" {
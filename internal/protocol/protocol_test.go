@@ -771,3 +771,34 @@ func TestMergeOutcome_Fields(t *testing.T) {
 		t.Errorf("MergeCommit = %q, want %q", outcome.MergeCommit, "abc123")
 	}
 }
+
+func TestFormatPolecatDoneBody_RoundTrip(t *testing.T) {
+	want := PolecatDonePayload{
+		Polecat:       "nux",
+		ExitType:      "COMPLETED",
+		Issue:         "gt-abc",
+		Branch:        "polecat/nux/gt-abc",
+		MR:            "gt-mr-1",
+		ConvoyID:      "hq-cv-1",
+		ConvoyOwned:   true,
+		MergeStrategy: "direct",
+		Errors:        "push retried",
+	}
+
+	body := FormatPolecatDoneBody(want)
+	got := ParsePolecatDonePayload("nux", body)
+	if *got != want {
+		t.Errorf("round trip = %+v, want %+v\nbody:\n%s", *got, want, body)
+	}
+}
+
+func TestFormatPolecatDoneBody_OmitsEmptyOptionalFields(t *testing.T) {
+	body := FormatPolecatDoneBody(PolecatDonePayload{ExitType: "DEFERRED", Branch: "polecat/nux"})
+	if body != "Exit: DEFERRED\nBranch: polecat/nux\n" {
+		t.Errorf("body = %q", body)
+	}
+	got := ParsePolecatDonePayload("nux", body+"Some free text after the record.")
+	if got.ExitType != "DEFERRED" || got.Branch != "polecat/nux" || got.MR != "" || got.Issue != "" {
+		t.Errorf("parsed = %+v", got)
+	}
+}
@@ -248,6 +248,9 @@ type PolecatDonePayload struct {
 
 	// Errors contains any non-fatal errors encountered during gt done.
 	Errors string `json:"errors,omitempty"`
+
+	// PRURL is a pull request linked with gt done --link-pr.
+	PRURL string `json:"pr_url,omitempty"`
 }
 
 // SkipMergeFlow returns true if this polecat's work should bypass the
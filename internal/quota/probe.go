@@ -0,0 +1,90 @@
+package quota
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// ProbeOutcome classifies the result of a test invocation of an agent.
+type ProbeOutcome string
+
+const (
+	// ProbeOK means the agent started and exited cleanly.
+	ProbeOK ProbeOutcome = "ok"
+
+	// ProbeAuthError means the account failed to authenticate.
+	ProbeAuthError ProbeOutcome = "auth_error"
+
+	// ProbeRateLimited means the account is rate-limited.
+	ProbeRateLimited ProbeOutcome = "rate_limited"
+
+	// ProbeQuotaExhausted means the account's billing quota is used up.
+	ProbeQuotaExhausted ProbeOutcome = "quota_exhausted"
+
+	// ProbeFailed means the agent failed for some other reason.
+	ProbeFailed ProbeOutcome = "failed"
+)
+
+// ProbeResult is the classified outcome of a test invocation.
+type ProbeResult struct {
+	Outcome     ProbeOutcome `json:"outcome"`
+	MatchedLine string       `json:"matched_line,omitempty"` // line that identified the failure
+	ResetsAt    string       `json:"resets_at,omitempty"`    // parsed reset time for rate limits
+}
+
+// authPatterns are the compiled DefaultAuthErrorPatterns.
+var authPatterns = func() []*regexp.Regexp {
+	compiled := make([]*regexp.Regexp, 0, len(constants.DefaultAuthErrorPatterns))
+	for _, p := range constants.DefaultAuthErrorPatterns {
+		compiled = append(compiled, regexp.MustCompile("(?i)"+p))
+	}
+	return compiled
+}()
+
+// ClassifyProbe classifies the output of a short agent invocation (such as
+// gt account test) using the scanner's rate-limit detection. runErr is the
+// error from running the agent, if any. Auth errors are checked first, then
+// quota exhaustion and rate limits; output that matches neither is OK if the agent exited
+// cleanly and failed otherwise.
+func (s *Scanner) ClassifyProbe(output string, runErr error) ProbeResult {
+	lines := strings.Split(output, "\n")
+
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		for _, re := range authPatterns {
+			if re.MatchString(line) {
+				return ProbeResult{Outcome: ProbeAuthError, MatchedLine: line}
+			}
+		}
+	}
+
+	if matched, _, ok := detectJSONQuotaExhausted(lines); ok {
+		return ProbeResult{Outcome: ProbeQuotaExhausted, MatchedLine: matched}
+	}
+
+	if matched, resetsAt, ok := s.matchRateLimit(lines); ok {
+		return ProbeResult{Outcome: ProbeRateLimited, MatchedLine: matched, ResetsAt: resetsAt}
+	}
+
+	if runErr != nil {
+		return ProbeResult{Outcome: ProbeFailed, MatchedLine: lastLine(lines, runErr)}
+	}
+	return ProbeResult{Outcome: ProbeOK}
+}
+
+// lastLine returns the last non-blank line of output, or the error text
+// when there is no output.
+func lastLine(lines []string, err error) string {
+	for i := len(lines) - 1; i >= 0; i-- {
+		if line := strings.TrimSpace(lines[i]); line != "" {
+			return line
+		}
+	}
+	return fmt.Sprint(err)
+}
@@ -0,0 +1,46 @@
+package quota
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestClassifyProbe(t *testing.T) {
+	scanner, err := NewScanner(&mockTmux{}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exit1 := errors.New("exit status 1")
+
+	tests := []struct {
+		name   string
+		output string
+		runErr error
+		want   ProbeOutcome
+	}{
+		{"clean exit", "OK\n", nil, ProbeOK},
+		{"invalid key", "Invalid API key · Please run /login\n", exit1, ProbeAuthError},
+		{"structured 401", `{"type":"error","error":{"type":"authentication_error","message":"invalid x-api-key"}}`, exit1, ProbeAuthError},
+		{"expired token is auth", "OAuth token has expired", exit1, ProbeAuthError},
+		{"rate limited", "You've hit your limit · resets 7pm (America/Los_Angeles)", exit1, ProbeRateLimited},
+		{"structured 429", `{"type":"error","error":{"type":"rate_limit_error","message":"Number of requests exceeded"}}`, exit1, ProbeRateLimited},
+		{"billing quota", `{"error":{"message":"You exceeded your current quota","type":"insufficient_quota","code":"insufficient_quota"}}`, exit1, ProbeQuotaExhausted},
+		{"other failure", "something broke\n", exit1, ProbeFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := scanner.ClassifyProbe(tt.output, tt.runErr)
+			if got.Outcome != tt.want {
+				t.Errorf("Outcome = %s (%q), want %s", got.Outcome, got.MatchedLine, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifyProbe_RateLimitResetTime(t *testing.T) {
+	scanner, _ := NewScanner(&mockTmux{}, nil, nil)
+	got := scanner.ClassifyProbe("You've hit your limit · resets 7pm", errors.New("exit status 1"))
+	if got.ResetsAt != "7pm" {
+		t.Errorf("ResetsAt = %q, want 7pm", got.ResetsAt)
+	}
+}
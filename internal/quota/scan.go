@@ -1,6 +1,8 @@
 package quota
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"os"
 	"regexp"
@@ -14,13 +16,15 @@ import (
 
 // ScanResult holds the result of scanning a single tmux session.
 type ScanResult struct {
-	Session       string    `json:"session"`                  // tmux session name
-	AccountHandle string    `json:"account_handle,omitempty"` // resolved account handle
-	ConfigDir     string    `json:"config_dir,omitempty"`     // CLAUDE_CONFIG_DIR (even if account unknown)
-	RateLimited   bool      `json:"rate_limited"`             // whether hard rate-limit was detected
-	NearLimit     bool      `json:"near_limit"`               // whether approaching-limit signal was detected
-	MatchedLine   string    `json:"matched_line,omitempty"`   // the line that matched (hard or warning)
-	ResetsAt      string    `json:"resets_at,omitempty"`      // parsed reset time if available
+	Session        string `json:"session"`                   // tmux session name
+	AccountHandle  string `json:"account_handle,omitempty"`  // resolved account handle
+	Provider       string `json:"provider,omitempty"`        // resolved account's API provider
+	ConfigDir      string `json:"config_dir,omitempty"`      // CLAUDE_CONFIG_DIR (even if account unknown)
+	RateLimited    bool   `json:"rate_limited"`              // whether hard rate-limit was detected
+	QuotaExhausted bool   `json:"quota_exhausted,omitempty"` // whether billing quota is exhausted (not fixed by rotation)
+	NearLimit      bool   `json:"near_limit"`                // whether approaching-limit signal was detected
+	MatchedLine    string `json:"matched_line,omitempty"`    // the line that matched (hard or warning)
+	ResetsAt       string `json:"resets_at,omitempty"`       // parsed reset time if available
 }
 
 // TmuxClient is the interface for tmux operations needed by the scanner.
@@ -62,6 +66,25 @@ func NewScanner(tmux TmuxClient, patterns []string, accounts *config.AccountsCon
 	}, nil
 }
 
+// AddPatterns compiles additional hard rate-limit patterns and appends them
+// to the scanner's list. Invalid patterns are skipped and reported in the
+// returned errors, so one bad entry does not disable detection.
+func (s *Scanner) AddPatterns(patterns []string) []error {
+	var errs []error
+	for _, p := range patterns {
+		if strings.TrimSpace(p) == "" {
+			continue
+		}
+		re, err := regexp.Compile("(?i)" + p)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("compiling pattern %q: %w", p, err))
+			continue
+		}
+		s.patterns = append(s.patterns, re)
+	}
+	return errs
+}
+
 // WithWarningPatterns enables near-limit detection via pane content patterns.
 // If patterns is nil, DefaultNearLimitPatterns are used.
 func (s *Scanner) WithWarningPatterns(patterns []string) error {
@@ -132,8 +155,11 @@ func (s *Scanner) scanSession(session string) ScanResult {
 		}
 	}
 
-	// Derive account from CLAUDE_CONFIG_DIR
+	// Derive account from CLAUDE_CONFIG_DIR, and its provider from accounts.json
 	result.AccountHandle = s.resolveAccountHandle(session)
+	if result.AccountHandle != "" {
+		result.Provider, _ = s.accounts.ProviderFor(result.AccountHandle)
+	}
 
 	// Capture pane content
 	content, err := s.tmux.CapturePane(session, scanLines)
@@ -152,20 +178,19 @@ func (s *Scanner) scanSession(session string) ScanResult {
 	}
 	bottomLines := allLines[start:]
 
-	// Check hard rate-limit patterns first
-	for _, line := range bottomLines {
-		line = strings.TrimSpace(line)
-		if line == "" {
-			continue
-		}
-		for _, re := range s.patterns {
-			if re.MatchString(line) {
-				result.RateLimited = true
-				result.MatchedLine = line
-				result.ResetsAt = parseResetTime(line)
-				return result
-			}
-		}
+	// Billing exhaustion is not a rate limit: swapping accounts burns another
+	// account on the same billing plan, so it must not be rotated.
+	if matched, _, ok := detectJSONQuotaExhausted(bottomLines); ok {
+		result.QuotaExhausted = true
+		result.MatchedLine = matched
+		return result
+	}
+
+	if matched, resetsAt, ok := s.matchRateLimit(bottomLines); ok {
+		result.RateLimited = true
+		result.MatchedLine = matched
+		result.ResetsAt = resetsAt
+		return result
 	}
 
 	// No hard limit detected — check near-limit warning patterns
@@ -188,6 +213,101 @@ func (s *Scanner) scanSession(session string) ScanResult {
 	return result
 }
 
+// matchRateLimit reports whether lines show a hard rate limit, returning the
+// matched line and any parsed reset time. Structured JSON errors carry an
+// explicit error type, so they are trusted before the text patterns.
+func (s *Scanner) matchRateLimit(lines []string) (matched, resetsAt string, ok bool) {
+	if matched, message, ok := detectJSONRateLimit(lines); ok {
+		return matched, parseResetTime(message), true
+	}
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		for _, re := range s.patterns {
+			if re.MatchString(line) {
+				return line, parseResetTime(line), true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// rateLimitErrorTypes are structured error type or code values that mean the
+// account is temporarily rate-limited:
+//
+//	Anthropic: {"type":"error","error":{"type":"rate_limit_error","message":"..."}}
+//	OpenAI:    {"error":{"message":"...","type":"requests","code":"rate_limit_exceeded"}}
+var rateLimitErrorTypes = map[string]bool{
+	"rate_limit_error":    true,
+	"rate_limit_exceeded": true,
+}
+
+// quotaExhaustedErrorTypes are structured error type or code values that mean
+// the account's billing quota is used up. Unlike a rate limit, it does not
+// reset on its own:
+//
+//	OpenAI: {"error":{"message":"You exceeded your current quota","type":"insufficient_quota"}}
+var quotaExhaustedErrorTypes = map[string]bool{
+	"insufficient_quota": true,
+}
+
+// jsonErrorEnvelope is the common shape of provider JSON error bodies.
+type jsonErrorEnvelope struct {
+	Error *struct {
+		Type    string `json:"type"`
+		Code    any    `json:"code"` // string for OpenAI; may be null or numeric elsewhere
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// maxJSONErrorLines caps how many pane lines one JSON error may span. Agents
+// sometimes pretty-print errors, and tmux wraps long lines.
+const maxJSONErrorLines = 12
+
+// detectJSONRateLimit looks for a JSON error object in lines whose error type
+// or code is a known rate-limit value.
+func detectJSONRateLimit(lines []string) (matched, message string, ok bool) {
+	return detectJSONError(lines, rateLimitErrorTypes)
+}
+
+// detectJSONQuotaExhausted looks for a JSON error object in lines whose error
+// type or code is a known quota-exhaustion value.
+func detectJSONQuotaExhausted(lines []string) (matched, message string, ok bool) {
+	return detectJSONError(lines, quotaExhaustedErrorTypes)
+}
+
+// detectJSONError looks for a JSON error object in lines whose error type or
+// code is in types. An object may span several lines. It returns the
+// compacted JSON and the error message on a match.
+func detectJSONError(lines []string, types map[string]bool) (matched, message string, ok bool) {
+	for i, line := range lines {
+		if !strings.HasPrefix(strings.TrimSpace(line), "{") {
+			continue
+		}
+		var buf strings.Builder
+		for j := i; j < len(lines) && j < i+maxJSONErrorLines; j++ {
+			buf.WriteString(strings.TrimSpace(lines[j]))
+			raw := []byte(buf.String())
+			if !json.Valid(raw) {
+				continue
+			}
+			var env jsonErrorEnvelope
+			if err := json.Unmarshal(raw, &env); err == nil && env.Error != nil {
+				code, _ := env.Error.Code.(string)
+				if types[env.Error.Type] || types[code] {
+					var compact bytes.Buffer
+					_ = json.Compact(&compact, raw)
+					return compact.String(), env.Error.Message, true
+				}
+			}
+			break
+		}
+	}
+	return "", "", false
+}
+
 // resolveAccountHandle maps a session's active account back to a handle.
 // Checks GT_QUOTA_ACCOUNT first (set by keychain swap rotation), then
 // falls back to matching CLAUDE_CONFIG_DIR against registered accounts.
@@ -2,6 +2,9 @@ package quota
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/steveyegge/gastown/internal/config"
@@ -410,6 +413,52 @@ func TestNewScanner_InvalidPattern(t *testing.T) {
 	}
 }
 
+func TestAddPatterns_FromAccountsConfig(t *testing.T) {
+	setupTestRegistry(t)
+
+	path := filepath.Join(t.TempDir(), "accounts.json")
+	data := `{"version":1,"accounts":{},"rate_limit_patterns":["quota exhausted for org","[broken",""]}`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	accounts, err := config.LoadAccountsConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmux := &mockTmux{
+		sessions: []string{"gt-crew-new", "gt-crew-ok"},
+		paneContent: map[string]string{
+			"gt-crew-new": "Error: Quota Exhausted for org acme",
+			"gt-crew-ok":  "working...",
+		},
+	}
+	scanner, err := NewScanner(tmux, nil, accounts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs := scanner.AddPatterns(accounts.RateLimitPatterns)
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "[broken") {
+		t.Errorf("AddPatterns errors = %v, want one for [broken", errs)
+	}
+
+	results, err := scanner.ScanAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]bool)
+	for _, r := range results {
+		got[r.Session] = r.RateLimited
+	}
+	if !got["gt-crew-new"] {
+		t.Error("expected extra pattern to detect gt-crew-new as rate-limited")
+	}
+	if got["gt-crew-ok"] {
+		t.Error("gt-crew-ok should not be rate-limited")
+	}
+}
+
 func TestResolveAccountHandle_TildeExpansion(t *testing.T) {
 	setupTestRegistry(t)
 
@@ -625,3 +674,165 @@ func TestWithWarningPatterns_InvalidPattern(t *testing.T) {
 		t.Error("expected error for invalid warning pattern")
 	}
 }
+
+func TestDetectJSONRateLimit(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		wantOK      bool
+		wantMatched string
+		wantMessage string
+	}{
+		{
+			name:        "anthropic single line",
+			content:     "some output\n" + `{"type":"error","error":{"type":"rate_limit_error","message":"Number of requests has exceeded your rate limit"}}`,
+			wantOK:      true,
+			wantMatched: `{"type":"error","error":{"type":"rate_limit_error","message":"Number of requests has exceeded your rate limit"}}`,
+			wantMessage: "Number of requests has exceeded your rate limit",
+		},
+		{
+			name: "anthropic pretty-printed across lines",
+			content: `Error from API:
+{
+  "type": "error",
+  "error": {
+    "type": "rate_limit_error",
+    "message": "rate limited"
+  }
+}
+❯ `,
+			wantOK:      true,
+			wantMatched: `{"type":"error","error":{"type":"rate_limit_error","message":"rate limited"}}`,
+			wantMessage: "rate limited",
+		},
+		{
+			name:        "openai code",
+			content:     `{"error":{"message":"Rate limit reached for gpt-4o. Please try again in 20s.","type":"requests","param":null,"code":"rate_limit_exceeded"}}`,
+			wantOK:      true,
+			wantMessage: "Rate limit reached for gpt-4o. Please try again in 20s.",
+		},
+		{
+			name:    "openai quota is not a rate limit",
+			content: `{"error":{"message":"You exceeded your current quota","type":"insufficient_quota","param":null,"code":null}}`,
+		},
+		{
+			name:    "other structured error",
+			content: `{"type":"error","error":{"type":"invalid_request_error","message":"bad"}}`,
+		},
+		{
+			name:    "json without error",
+			content: `{"type":"message","content":"rate_limit_error"}`,
+		},
+		{
+			name:    "unterminated json",
+			content: "{\n  \"type\": \"error\",",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, message, ok := detectJSONRateLimit(strings.Split(tt.content, "\n"))
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v (matched %q)", ok, tt.wantOK, matched)
+			}
+			if tt.wantMatched != "" && matched != tt.wantMatched {
+				t.Errorf("matched = %q, want %q", matched, tt.wantMatched)
+			}
+			if tt.wantMessage != "" && message != tt.wantMessage {
+				t.Errorf("message = %q, want %q", message, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestScanAll_DetectsStructuredJSONRateLimit(t *testing.T) {
+	setupTestRegistry(t)
+
+	content := `  ◆ Bash(run agent)
+{"type":"error","error":{"type":"rate_limit_error","message":"You've exceeded the limit · resets 7pm (America/Los_Angeles)"}}
+
+❯ `
+	tmux := &mockTmux{
+		sessions:    []string{"gt-crew-bear"},
+		paneContent: map[string]string{"gt-crew-bear": content},
+		envVars:     map[string]map[string]string{"gt-crew-bear": {}},
+	}
+	// Custom patterns that can't match, so only the JSON path can detect it.
+	scanner, err := NewScanner(tmux, []string{`never-matches-anything`}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := scanner.ScanAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || !results[0].RateLimited {
+		t.Fatalf("expected structured JSON rate limit to be detected, got %+v", results)
+	}
+	if results[0].ResetsAt != "7pm (America/Los_Angeles)" {
+		t.Errorf("ResetsAt = %q, want reset time from the error message", results[0].ResetsAt)
+	}
+}
+
+func TestScanAll_QuotaExhaustedIsNotRateLimited(t *testing.T) {
+	setupTestRegistry(t)
+	content := `{"error":{"message":"You exceeded your current quota","type":"insufficient_quota","param":null,"code":"insufficient_quota"}}`
+	tmux := &mockTmux{
+		sessions:    []string{"gt-crew-bear"},
+		paneContent: map[string]string{"gt-crew-bear": content},
+		envVars:     map[string]map[string]string{"gt-crew-bear": {}},
+	}
+	scanner, err := NewScanner(tmux, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := scanner.ScanAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	if !results[0].QuotaExhausted {
+		t.Errorf("expected quota exhaustion to be detected, got %+v", results[0])
+	}
+	if results[0].RateLimited {
+		t.Error("quota exhaustion must not be reported as a rate limit, or rotation would swap accounts for it")
+	}
+}
+
+func TestScanAll_InfersProviderFromAccount(t *testing.T) {
+	setupTestRegistry(t)
+	tmux := &mockTmux{
+		sessions:    []string{"gt-crew-bear", "gt-crew-wolf", "gt-crew-fox"},
+		paneContent: map[string]string{"gt-crew-bear": "working", "gt-crew-wolf": "working", "gt-crew-fox": "working"},
+		envVars: map[string]map[string]string{
+			"gt-crew-bear": {"CLAUDE_CONFIG_DIR": "/home/user/.claude-accounts/work"},
+			"gt-crew-wolf": {"CLAUDE_CONFIG_DIR": "/home/user/.claude-accounts/codex"},
+			"gt-crew-fox":  {"CLAUDE_CONFIG_DIR": "/home/user/.claude-accounts/unregistered"},
+		},
+	}
+	accounts := &config.AccountsConfig{
+		Accounts: map[string]config.Account{
+			"work":  {ConfigDir: "/home/user/.claude-accounts/work"},
+			"codex": {ConfigDir: "/home/user/.claude-accounts/codex", Provider: "openai"},
+		},
+	}
+
+	scanner, err := NewScanner(tmux, nil, accounts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := scanner.ScanAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{"gt-crew-bear": "anthropic", "gt-crew-wolf": "openai", "gt-crew-fox": ""}
+	for _, r := range results {
+		if r.Provider != want[r.Session] {
+			t.Errorf("%s: Provider = %q, want %q", r.Session, r.Provider, want[r.Session])
+		}
+	}
+}
@@ -154,6 +154,7 @@ type MRInfo struct {
 	ID              string     // Bead ID (e.g., "gt-abc123")
 	Branch          string     // Source branch (e.g., "polecat/nux")
 	Target          string     // Target branch (e.g., "main")
+	Remote          string     // Remote the branch was pushed to (empty = origin)
 	SourceIssue     string     // The work item being merged
 	Worker          string     // Who did the work
 	Rig             string     // Which rig
@@ -179,6 +180,15 @@ type MRInfo struct {
 	BranchExistsRemote bool      // Whether the MR branch exists in remote tracking refs
 }
 
+// PushRemote returns the remote the MR branch was pushed to, defaulting to
+// origin when none was recorded.
+func (mr *MRInfo) PushRemote() string {
+	if mr.Remote != "" {
+		return mr.Remote
+	}
+	return "origin"
+}
+
 // MRAnomaly represents an MR queue health problem that can stall processing.
 type MRAnomaly struct {
 	ID       string        `json:"id"`
@@ -392,11 +402,18 @@ type ProcessResult struct {
 	BranchNotFound bool // Source branch no longer exists (e.g. cleaned up after cherry-pick)
 }
 
-// doMerge performs the actual git merge operation.
+// doMerge performs the actual git merge operation. branch is a local branch
+// name or a fully-qualified ref (refs/remotes/...).
 func (e *Engineer) doMerge(ctx context.Context, branch, target, sourceIssue string, skipGates ...bool) ProcessResult {
 	// Step 1: Verify source branch exists locally (shared .repo.git with polecats)
 	_, _ = fmt.Fprintf(e.output, "[Engineer] Checking local branch %s...\n", branch)
-	exists, err := e.git.BranchExists(branch)
+	var exists bool
+	var err error
+	if strings.HasPrefix(branch, "refs/") {
+		exists, err = e.git.RefExists(branch)
+	} else {
+		exists, err = e.git.BranchExists(branch)
+	}
 	if err != nil {
 		return ProcessResult{
 			Success: false,
@@ -899,7 +916,27 @@ func (e *Engineer) ProcessMRInfo(ctx context.Context, mr *MRInfo) ProcessResult
 	}
 
 	// Use the shared merge logic
-	return e.doMerge(ctx, mr.Branch, mr.Target, mr.SourceIssue, skipGates)
+	return e.doMerge(ctx, e.mergeRefForMR(mr), mr.Target, mr.SourceIssue, skipGates)
+}
+
+// mergeRefForMR returns the ref doMerge should merge for mr. Branches pushed
+// to a non-origin remote (gt done --remote) are fetched on every attempt into
+// refs/remotes/<remote>/<branch>, so a re-push after conflict rework is
+// merged rather than a stale local copy. The remote-tracking ref can be
+// updated even while a polecat worktree has the branch checked out. If the
+// fetch fails, the local branch is used.
+func (e *Engineer) mergeRefForMR(mr *MRInfo) string {
+	remote := mr.PushRemote()
+	if remote == "origin" {
+		return mr.Branch
+	}
+	ref := "refs/remotes/" + remote + "/" + mr.Branch
+	_, _ = fmt.Fprintf(e.output, "[Engineer] Fetching %s from %s...\n", mr.Branch, remote)
+	if err := e.git.FetchBranch(remote, "+refs/heads/"+mr.Branch+":"+ref); err != nil {
+		_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: fetch %s from %s: %v (using local branch)\n", mr.Branch, remote, err)
+		return mr.Branch
+	}
+	return ref
 }
 
 // HandleMRInfoSuccess handles a successful merge from MRInfo.
@@ -983,7 +1020,7 @@ func (e *Engineer) HandleMRInfoSuccess(mr *MRInfo, result ProcessResult) {
 		// to contributor forks with open upstream PRs; deleting them from origin
 		// causes GitHub to auto-close those PRs via head_ref_delete. (GH#2669)
 		if isPolecat {
-			if err := e.git.DeleteRemoteBranch("origin", mr.Branch); err != nil {
+			if err := e.git.DeleteRemoteBranch(mr.PushRemote(), mr.Branch); err != nil {
 				_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: failed to delete remote branch %s: %v\n", mr.Branch, err)
 			} else {
 				_, _ = fmt.Fprintf(e.output, "[Engineer] Deleted remote branch: %s\n", mr.Branch)
@@ -1199,6 +1236,15 @@ The Refinery will automatically retry the merge after you force-push.`,
 
 	_, _ = fmt.Fprintf(e.output, "[Engineer] Created conflict resolution task: %s (P%d)\n", task.ID, task.Priority)
 
+	// Record the conflict cycle on the MR bead so retry_count feeds priority
+	// scoring and the MR links to its resolution task.
+	if _, err := e.beads.IncrementMRRetry(mr.ID, mainSHA); err != nil {
+		_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: failed to record retry on MR %s: %v\n", mr.ID, err)
+	}
+	if err := e.beads.SetConflictTask(mr.ID, task.ID); err != nil {
+		_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: failed to link conflict task on MR %s: %v\n", mr.ID, err)
+	}
+
 	return task.ID, nil
 }
 
@@ -1250,6 +1296,7 @@ func issueToMRInfo(issue *beads.Issue, fields *beads.MRFields) *MRInfo {
 		ID:              issue.ID,
 		Branch:          fields.Branch,
 		Target:          fields.Target,
+		Remote:          fields.Remote,
 		SourceIssue:     fields.SourceIssue,
 		Worker:          fields.Worker,
 		Rig:             fields.Rig,
@@ -1322,9 +1369,9 @@ func (e *Engineer) ListReadyMRs() ([]*MRInfo, error) {
 			continue
 		}
 
-		fields := beads.ParseMRFields(issue)
+		fields := e.readyMRFields(issue)
 		if fields == nil {
-			continue // Skip issues without MR fields
+			continue
 		}
 
 		// Skip if already assigned, unless claim is stale (allows re-claim after crash).
@@ -1349,6 +1396,28 @@ func (e *Engineer) ListReadyMRs() ([]*MRInfo, error) {
 	return mrs, nil
 }
 
+// readyMRFields parses an MR's fields for ListReadyMRs, or returns nil if the
+// MR cannot be merged. Only a missing branch is fatal: a missing target
+// defaults to the rig's default branch (as in the manager), and other
+// malformed fields are logged so the description can be fixed.
+func (e *Engineer) readyMRFields(issue *beads.Issue) *beads.MRFields {
+	fields := beads.ParseMRFields(issue)
+	if fields == nil {
+		return nil // Skip issues without MR fields
+	}
+	if fields.Branch == "" {
+		_, _ = fmt.Fprintf(e.output, "[Engineer] Skipping MR %s: no branch in description\n", issue.ID)
+		return nil
+	}
+	if _, err := beads.ParseMRFieldsStrict(issue); err != nil {
+		_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: MR %s: %v\n", issue.ID, err)
+	}
+	if fields.Target == "" {
+		fields.Target = e.rig.DefaultBranch()
+	}
+	return fields
+}
+
 // ListBlockedMRs returns MRs that are blocked by open tasks.
 // Useful for monitoring/reporting.
 //
@@ -1421,7 +1490,7 @@ func (e *Engineer) ListAllOpenMRs() ([]*MRInfo, error) {
 
 		// Check branch existence (local + remote tracking refs)
 		mr.BranchExistsLocal, _ = e.git.BranchExists(fields.Branch)
-		mr.BranchExistsRemote, _ = e.git.RemoteTrackingBranchExists("origin", fields.Branch)
+		mr.BranchExistsRemote, _ = e.git.RemoteTrackingBranchExists(fields.PushRemote(), fields.Branch)
 		mr.BlockedBy = e.firstOpenBlocker(issue)
 
 		mrs = append(mrs, mr)
@@ -1442,12 +1511,12 @@ func (e *Engineer) ListQueueAnomalies(now time.Time) ([]*MRAnomaly, error) {
 		return nil, fmt.Errorf("querying beads for merge-requests: %w", err)
 	}
 
-	return detectQueueAnomalies(issues, now, e.config.StaleClaimWarningAfter, func(branch string) (bool, bool, error) {
+	return detectQueueAnomalies(issues, now, e.config.StaleClaimWarningAfter, func(branch, remote string) (bool, bool, error) {
 		localExists, err := e.git.BranchExists(branch)
 		if err != nil {
 			return false, false, err
 		}
-		remoteTrackingExists, err := e.git.RemoteTrackingBranchExists("origin", branch)
+		remoteTrackingExists, err := e.git.RemoteTrackingBranchExists(remote, branch)
 		if err != nil {
 			return false, false, err
 		}
@@ -1459,7 +1528,7 @@ func detectQueueAnomalies(
 	issues []*beads.Issue,
 	now time.Time,
 	warningAfter time.Duration,
-	branchExistsFn func(branch string) (localExists bool, remoteTrackingExists bool, err error),
+	branchExistsFn func(branch, remote string) (localExists bool, remoteTrackingExists bool, err error),
 ) []*MRAnomaly {
 	var anomalies []*MRAnomaly
 
@@ -1492,13 +1561,14 @@ func detectQueueAnomalies(
 
 		// 2) Orphaned branch detection.
 		// ZFC: report raw anomaly data. Agent decides severity.
-		localExists, remoteTrackingExists, err := branchExistsFn(fields.Branch)
+		remote := fields.PushRemote()
+		localExists, remoteTrackingExists, err := branchExistsFn(fields.Branch, remote)
 		if err == nil && !localExists && !remoteTrackingExists {
 			anomalies = append(anomalies, &MRAnomaly{
 				ID:     issue.ID,
 				Branch: fields.Branch,
 				Type:   "orphaned-branch",
-				Detail: "MR branch is missing locally and in origin/* tracking refs",
+				Detail: "MR branch is missing locally and in " + remote + "/* tracking refs",
 			})
 		}
 	}
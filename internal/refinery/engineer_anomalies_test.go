@@ -30,7 +30,7 @@ worker: nux`,
 		},
 	}
 
-	anomalies := detectQueueAnomalies(issues, now, 2*time.Hour, func(branch string) (bool, bool, error) {
+	anomalies := detectQueueAnomalies(issues, now, 2*time.Hour, func(branch, remote string) (bool, bool, error) {
 		return true, false, nil
 	})
 
@@ -76,7 +76,7 @@ worker: nux`,
 		},
 	}
 
-	anomalies := detectQueueAnomalies(issues, now, 2*time.Hour, func(branch string) (bool, bool, error) {
+	anomalies := detectQueueAnomalies(issues, now, 2*time.Hour, func(branch, remote string) (bool, bool, error) {
 		if branch == "polecat/orphan" {
 			return false, false, nil
 		}
@@ -94,3 +94,30 @@ worker: nux`,
 		t.Fatalf("anomaly ID = %q, want gt-orphan", anomalies[0].ID)
 	}
 }
+
+func TestDetectQueueAnomalies_ChecksRecordedRemote(t *testing.T) {
+	now := time.Date(2026, 2, 10, 12, 0, 0, 0, time.UTC)
+	issues := []*beads.Issue{
+		{
+			ID:        "gt-staged",
+			Status:    "open",
+			UpdatedAt: now.Add(-30 * time.Minute).Format(time.RFC3339),
+			Description: `branch: polecat/staged
+target: main
+remote: staging`,
+		},
+	}
+
+	var checked []string
+	anomalies := detectQueueAnomalies(issues, now, 2*time.Hour, func(branch, remote string) (bool, bool, error) {
+		checked = append(checked, remote)
+		return false, remote == "staging", nil
+	})
+
+	if len(anomalies) != 0 {
+		t.Fatalf("expected no anomalies, got %+v", anomalies)
+	}
+	if len(checked) != 1 || checked[0] != "staging" {
+		t.Fatalf("checked remotes = %v, want [staging]", checked)
+	}
+}
@@ -839,3 +839,90 @@ func TestIsClaimStale(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeRefForMR_FetchesPushRemoteEveryTime(t *testing.T) {
+	workDir, g, _ := testGitRepo(t)
+	tmpDir := filepath.Dir(workDir)
+
+	// A fork the polecat pushed to with gt done --remote, and the polecat's
+	// clone of it.
+	forkDir := filepath.Join(tmpDir, "fork.git")
+	run(t, tmpDir, "git", "clone", "--bare", filepath.Join(tmpDir, "origin.git"), forkDir)
+	run(t, workDir, "git", "remote", "add", "fork", forkDir)
+	polecatDir := filepath.Join(tmpDir, "polecat")
+	run(t, tmpDir, "git", "clone", forkDir, polecatDir)
+	run(t, polecatDir, "git", "config", "user.email", "test@test.com")
+	run(t, polecatDir, "git", "config", "user.name", "Test")
+	createFeatureBranch(t, polecatDir, "polecat/nux", "feature.txt", "first\n")
+	run(t, polecatDir, "git", "push", "origin", "polecat/nux")
+
+	e := newTestEngineer(t, workDir, g)
+	mr := makeMR("gt-mr-1", "polecat/nux", "main")
+	mr.Remote = "fork"
+
+	ref := e.mergeRefForMR(mr)
+	if ref != "refs/remotes/fork/polecat/nux" {
+		t.Fatalf("mergeRefForMR = %q, want the fork's remote-tracking ref", ref)
+	}
+	// The refinery already has a local copy of the first push.
+	run(t, workDir, "git", "branch", "polecat/nux", ref)
+
+	// Conflict rework: the polecat re-pushes.
+	run(t, polecatDir, "git", "checkout", "polecat/nux")
+	writeFile(t, polecatDir, "feature.txt", "reworked\n")
+	run(t, polecatDir, "git", "commit", "-am", "rework")
+	run(t, polecatDir, "git", "push", "origin", "polecat/nux")
+	want := run(t, polecatDir, "git", "rev-parse", "HEAD")
+
+	ref = e.mergeRefForMR(mr)
+	if got := run(t, workDir, "git", "rev-parse", ref); got != want {
+		t.Errorf("%s = %s, want the re-pushed commit %s", ref, got, want)
+	}
+}
+
+func TestMergeRefForMR_OriginUsesLocalBranch(t *testing.T) {
+	workDir, g, _ := testGitRepo(t)
+	e := newTestEngineer(t, workDir, g)
+	if ref := e.mergeRefForMR(makeMR("gt-mr-1", "polecat/nux", "main")); ref != "polecat/nux" {
+		t.Errorf("mergeRefForMR = %q, want the local branch", ref)
+	}
+}
+
+func TestReadyMRFields(t *testing.T) {
+	rigPath := t.TempDir()
+	if err := os.WriteFile(filepath.Join(rigPath, "config.json"), []byte(`{"default_branch":"develop"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	e := NewEngineer(&rig.Rig{Name: "test-rig", Path: rigPath})
+	out := &bytes.Buffer{}
+	e.output = out
+
+	tests := []struct {
+		name       string
+		desc       string
+		wantTarget string // "" means the MR is skipped
+		wantLog    string
+	}{
+		{"complete", "branch: polecat/nux\ntarget: main", "main", ""},
+		{"missing target defaults", "branch: polecat/nux\nworker: nux", "develop", "missing target"},
+		{"bad retry_count still ready", "branch: polecat/nux\ntarget: main\nretry_count: lots", "main", "invalid retry_count"},
+		{"missing branch skipped", "target: main\nworker: nux", "", "no branch"},
+		{"no MR fields", "Just prose.", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out.Reset()
+			fields := e.readyMRFields(&beads.Issue{ID: "gt-mr-1", Description: tt.desc})
+			if tt.wantTarget == "" {
+				if fields != nil {
+					t.Errorf("readyMRFields = %+v, want MR skipped", fields)
+				}
+			} else if fields == nil || fields.Target != tt.wantTarget {
+				t.Errorf("readyMRFields = %+v, want target %q", fields, tt.wantTarget)
+			}
+			if tt.wantLog != "" && !strings.Contains(out.String(), tt.wantLog) {
+				t.Errorf("output = %q, want it to mention %q", out.String(), tt.wantLog)
+			}
+		})
+	}
+}
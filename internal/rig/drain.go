@@ -0,0 +1,45 @@
+package rig
+
+import (
+	"sort"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/wisp"
+)
+
+// DrainingKey is the wisp config key set while a rig is draining: in-flight
+// work continues and agents keep running, but no new work is dispatched.
+const DrainingKey = "draining"
+
+// IsDraining reports whether the rig is draining.
+func IsDraining(townRoot, rigName string) bool {
+	return wisp.NewConfig(townRoot, rigName).GetBool(DrainingKey)
+}
+
+// SetDraining turns drain mode on or off for a rig. Like parking, this is
+// local wisp state and does not sync.
+func SetDraining(townRoot, rigName string, draining bool) error {
+	cfg := wisp.NewConfig(townRoot, rigName)
+	if draining {
+		return cfg.Set(DrainingKey, true)
+	}
+	return cfg.Unset(DrainingKey)
+}
+
+// DrainingRigs returns the sorted names of registered rigs that are
+// draining. Returns nil if the rig registry can't be read.
+func DrainingRigs(townRoot string) []string {
+	rigsConfig, err := config.LoadRigsConfig(constants.MayorRigsPath(townRoot))
+	if err != nil {
+		return nil
+	}
+	var draining []string
+	for name := range rigsConfig.Rigs {
+		if IsDraining(townRoot, name) {
+			draining = append(draining, name)
+		}
+	}
+	sort.Strings(draining)
+	return draining
+}
@@ -0,0 +1,59 @@
+package rig
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+func TestSetDraining(t *testing.T) {
+	townRoot := t.TempDir()
+
+	if IsDraining(townRoot, "gastown") {
+		t.Fatal("new rig should not be draining")
+	}
+	if err := SetDraining(townRoot, "gastown", true); err != nil {
+		t.Fatalf("SetDraining(true): %v", err)
+	}
+	if !IsDraining(townRoot, "gastown") {
+		t.Error("rig should be draining after SetDraining(true)")
+	}
+	if IsDraining(townRoot, "beads") {
+		t.Error("drain mode leaked to another rig")
+	}
+	if err := SetDraining(townRoot, "gastown", false); err != nil {
+		t.Fatalf("SetDraining(false): %v", err)
+	}
+	if IsDraining(townRoot, "gastown") {
+		t.Error("rig should not be draining after SetDraining(false)")
+	}
+}
+
+func TestDrainingRigs(t *testing.T) {
+	townRoot := t.TempDir()
+	rigsConfig := &config.RigsConfig{
+		Version: 1,
+		Rigs: map[string]config.RigEntry{
+			"gastown": {},
+			"beads":   {},
+			"wyvern":  {},
+		},
+	}
+	if err := config.SaveRigsConfig(constants.MayorRigsPath(townRoot), rigsConfig); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"wyvern", "beads"} {
+		if err := SetDraining(townRoot, name, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got, want := DrainingRigs(townRoot), []string{"beads", "wyvern"}; !slices.Equal(got, want) {
+		t.Errorf("DrainingRigs = %v, want %v", got, want)
+	}
+	if got := DrainingRigs(t.TempDir()); got != nil {
+		t.Errorf("DrainingRigs without registry = %v, want nil", got)
+	}
+}
@@ -90,6 +90,7 @@ type RigConfig struct {
 	UpstreamURL   string       `json:"upstream_url,omitempty"`   // optional upstream URL (for fork workflows)
 	LocalRepo     string       `json:"local_repo,omitempty"`     // optional local reference repo
 	DefaultBranch string       `json:"default_branch,omitempty"` // main, master, etc.
+	PushRemote    string       `json:"push_remote,omitempty"`    // remote gt done pushes branches to (default: origin)
 	CreatedAt     time.Time    `json:"created_at"`               // when rig was created
 	Beads         *BeadsConfig `json:"beads,omitempty"`
 
@@ -840,6 +841,30 @@ func LoadRigConfig(rigPath string) (*RigConfig, error) {
 	return &cfg, nil
 }
 
+// DefaultBranch returns the default branch for the rig at rigPath without
+// loading the full rig config: it reads only default_branch from config.json,
+// then falls back to origin/HEAD in the mayor's clone, then to "main".
+// An error is returned only when config.json exists but cannot be parsed.
+func DefaultBranch(rigPath string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(rigPath, "config.json")) //nolint:gosec // G304: path is constructed internally
+	if err == nil {
+		var cfg struct {
+			DefaultBranch string `json:"default_branch"`
+		}
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return "main", fmt.Errorf("parsing rig config: %w", err)
+		}
+		if cfg.DefaultBranch != "" {
+			return cfg.DefaultBranch, nil
+		}
+	}
+
+	if branch, err := git.NewGit(filepath.Join(rigPath, "mayor", "rig")).RemoteHEADBranch("origin"); err == nil && branch != "" {
+		return branch, nil
+	}
+	return "main", nil
+}
+
 // warnDeprecatedRigConfigKeys detects merge_queue keys in rig root config.json
 // that are silently ignored by json.Unmarshal (RigConfig has no merge_queue field).
 // Without this warning, users can set merge_queue.target_branch believing it
@@ -1419,3 +1419,61 @@ func TestBareCloneDefaultBranch(t *testing.T) {
 		t.Errorf("DefaultBranch() = %q, want %q", got, "master")
 	}
 }
+
+func TestDefaultBranch(t *testing.T) {
+	t.Run("config specified", func(t *testing.T) {
+		rigPath := t.TempDir()
+		cfg := `{"type": "rig", "name": "r", "default_branch": "develop", "beads": {"prefix": "r"}}`
+		if err := os.WriteFile(filepath.Join(rigPath, "config.json"), []byte(cfg), 0644); err != nil {
+			t.Fatal(err)
+		}
+		got, err := DefaultBranch(rigPath)
+		if err != nil || got != "develop" {
+			t.Errorf("DefaultBranch() = %q, %v; want develop", got, err)
+		}
+	})
+
+	t.Run("git detected", func(t *testing.T) {
+		rigPath := t.TempDir()
+		clone := filepath.Join(rigPath, "mayor", "rig")
+		if err := os.MkdirAll(clone, 0755); err != nil {
+			t.Fatal(err)
+		}
+		for _, args := range [][]string{
+			{"init"},
+			{"symbolic-ref", "refs/remotes/origin/HEAD", "refs/remotes/origin/trunk"},
+		} {
+			cmd := exec.Command("git", args...)
+			cmd.Dir = clone
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("git %v: %v\n%s", args, err, out)
+			}
+		}
+		// config.json without default_branch defers to git.
+		if err := os.WriteFile(filepath.Join(rigPath, "config.json"), []byte(`{"name": "r"}`), 0644); err != nil {
+			t.Fatal(err)
+		}
+		got, err := DefaultBranch(rigPath)
+		if err != nil || got != "trunk" {
+			t.Errorf("DefaultBranch() = %q, %v; want trunk", got, err)
+		}
+	})
+
+	t.Run("fallback", func(t *testing.T) {
+		got, err := DefaultBranch(t.TempDir())
+		if err != nil || got != "main" {
+			t.Errorf("DefaultBranch() = %q, %v; want main", got, err)
+		}
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		rigPath := t.TempDir()
+		if err := os.WriteFile(filepath.Join(rigPath, "config.json"), []byte("{"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		got, err := DefaultBranch(rigPath)
+		if err == nil || got != "main" {
+			t.Errorf("DefaultBranch() = %q, %v; want main with error", got, err)
+		}
+	})
+}
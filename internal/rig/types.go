@@ -87,12 +87,10 @@ func (r *Rig) BeadsPath() string {
 	return r.Path
 }
 
-// DefaultBranch returns the configured default branch for this rig.
-// Falls back to "main" if not configured or if config cannot be loaded.
+// DefaultBranch returns the default branch for this rig, resolved the same
+// way as the package-level DefaultBranch. An unreadable config falls back
+// to "main".
 func (r *Rig) DefaultBranch() string {
-	cfg, err := LoadRigConfig(r.Path)
-	if err != nil || cfg.DefaultBranch == "" {
-		return "main"
-	}
-	return cfg.DefaultBranch
+	branch, _ := DefaultBranch(r.Path)
+	return branch
 }
@@ -14,7 +14,7 @@ var AllowedEnvVars = []string{
 	// Gas Town
 	"GT_ROOT", "GT_RIG", "GT_ROLE", "GT_POLECAT", "GT_POLECAT_PATH",
 	"GT_BRANCH", "GT_TOWN_ROOT", "GT_RUN", "GT_CREW",
-	"GT_AGENT", "GT_PROCESS_NAMES", "GT_SESSION_ID_ENV",
+	"GT_AGENT", "GT_PROFILE", "GT_PROCESS_NAMES", "GT_SESSION_ID_ENV",
 	"BD_ACTOR", "BD_DOLT_AUTO_COMMIT", "BD_DOLT_HOST", "BD_DOLT_PORT",
 
 	// Agent runtime
@@ -5,6 +5,7 @@ package style
 import (
 	"fmt"
 	"os"
+	"sync/atomic"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/steveyegge/gastown/internal/ui"
@@ -51,10 +52,68 @@ var (
 	ArrowPrefix = Info.Render("→")
 )
 
+// Verbosity controls how much the print helpers in this package write.
+// Errors are returned to the caller rather than printed here, so they are
+// never suppressed.
+type Verbosity int32
+
+const (
+	// VerbosityQuiet suppresses progress output and warnings.
+	VerbosityQuiet Verbosity = -1
+
+	// VerbosityNormal prints progress output and warnings (the default).
+	VerbosityNormal Verbosity = 0
+
+	// VerbosityVerbose additionally prints Verbosef detail lines.
+	VerbosityVerbose Verbosity = 1
+)
+
+// verbosity holds the process-wide level, set from the global --quiet and
+// --verbose flags.
+var verbosity atomic.Int32
+
+// SetVerbosity sets the process-wide output level.
+func SetVerbosity(v Verbosity) {
+	verbosity.Store(int32(v))
+}
+
+// GetVerbosity returns the process-wide output level.
+func GetVerbosity() Verbosity {
+	return Verbosity(verbosity.Load())
+}
+
+// Printf prints progress output to stdout unless the level is quiet.
+func Printf(format string, args ...interface{}) {
+	if GetVerbosity() <= VerbosityQuiet {
+		return
+	}
+	fmt.Printf(format, args...)
+}
+
+// Println prints progress output to stdout unless the level is quiet.
+func Println(args ...interface{}) {
+	if GetVerbosity() <= VerbosityQuiet {
+		return
+	}
+	fmt.Println(args...)
+}
+
+// Verbosef prints detail output to stdout only at the verbose level.
+func Verbosef(format string, args ...interface{}) {
+	if GetVerbosity() < VerbosityVerbose {
+		return
+	}
+	fmt.Printf(format, args...)
+}
+
 // PrintWarning prints a warning message to stderr with consistent formatting.
 // The format and args work like fmt.Printf.
 // Writes to stderr so warnings never contaminate structured (JSON) output on stdout.
+// Suppressed at the quiet level.
 func PrintWarning(format string, args ...interface{}) {
+	if GetVerbosity() <= VerbosityQuiet {
+		return
+	}
 	msg := fmt.Sprintf(format, args...)
 	fmt.Fprintf(os.Stderr, "%s %s\n", Warning.Render(ui.IconWarn+" Warning:"), msg)
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -189,6 +190,77 @@ func TestMultiplePrintWarning(t *testing.T) {
 	}
 }
 
+// captureOutput runs fn and returns what it wrote to stdout and stderr.
+func captureOutput(t *testing.T, fn func()) (stdout, stderr string) {
+	t.Helper()
+	oldStdout, oldStderr := os.Stdout, os.Stderr
+	stdoutR, stdoutW, _ := os.Pipe()
+	stderrR, stderrW, _ := os.Pipe()
+	os.Stdout, os.Stderr = stdoutW, stderrW
+
+	fn()
+
+	stdoutW.Close()
+	stderrW.Close()
+	os.Stdout, os.Stderr = oldStdout, oldStderr
+
+	var stdoutBuf, stderrBuf bytes.Buffer
+	io.Copy(&stdoutBuf, stdoutR)
+	io.Copy(&stderrBuf, stderrR)
+	return stdoutBuf.String(), stderrBuf.String()
+}
+
+func printAllLevels() {
+	Printf("progress %d\n", 1)
+	Println("progress line")
+	Verbosef("detail %s\n", "x")
+	PrintWarning("careful")
+}
+
+func TestVerbosity_Default(t *testing.T) {
+	if got := GetVerbosity(); got != VerbosityNormal {
+		t.Fatalf("default verbosity = %d, want normal", got)
+	}
+	stdout, stderr := captureOutput(t, printAllLevels)
+	if !strings.Contains(stdout, "progress 1") || !strings.Contains(stdout, "progress line") {
+		t.Errorf("normal level should print progress, got %q", stdout)
+	}
+	if strings.Contains(stdout, "detail") {
+		t.Errorf("normal level should not print verbose detail, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "careful") {
+		t.Errorf("normal level should print warnings, got %q", stderr)
+	}
+}
+
+func TestVerbosity_QuietSuppressesOutput(t *testing.T) {
+	SetVerbosity(VerbosityQuiet)
+	t.Cleanup(func() { SetVerbosity(VerbosityNormal) })
+
+	stdout, stderr := captureOutput(t, printAllLevels)
+	if stdout != "" {
+		t.Errorf("quiet level wrote stdout: %q", stdout)
+	}
+	if stderr != "" {
+		t.Errorf("quiet level wrote stderr: %q", stderr)
+	}
+}
+
+func TestVerbosity_VerbosePrintsEverything(t *testing.T) {
+	SetVerbosity(VerbosityVerbose)
+	t.Cleanup(func() { SetVerbosity(VerbosityNormal) })
+
+	stdout, stderr := captureOutput(t, printAllLevels)
+	for _, want := range []string{"progress 1", "progress line", "detail x"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("verbose stdout missing %q: %q", want, stdout)
+		}
+	}
+	if !strings.Contains(stderr, "careful") {
+		t.Errorf("verbose level should print warnings, got %q", stderr)
+	}
+}
+
 func ExamplePrintWarning() {
 	// PrintWarning writes to stderr (not stdout) to avoid contaminating
 	// structured output like JSON. This example demonstrates usage;
@@ -1761,6 +1761,64 @@ func (t *Tmux) findAgentPaneByScan(session string) (string, error) {
 	return "", nil
 }
 
+// PaneInfo describes one pane of a session, as reported by list-panes.
+type PaneInfo struct {
+	ID      string // Pane ID, e.g. "%5"
+	Command string // Foreground command (pane_current_command)
+	PID     string // PID of the pane's initial process
+	Dead    bool   // Process exited and the pane was kept by remain-on-exit
+}
+
+// ListPanes returns every pane across all windows of a session.
+func (t *Tmux) ListPanes(session string) ([]PaneInfo, error) {
+	out, err := t.run("list-panes", "-s", "-t", session, "-F", "#{pane_id}\t#{pane_dead}\t#{pane_current_command}\t#{pane_pid}")
+	if err != nil {
+		return nil, err
+	}
+	return parsePaneList(out), nil
+}
+
+// parsePaneList parses list-panes output in the ListPanes format.
+// Malformed lines are skipped.
+func parsePaneList(out string) []PaneInfo {
+	var panes []PaneInfo
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		parts := strings.SplitN(line, "\t", 4)
+		if len(parts) < 4 || parts[0] == "" {
+			continue
+		}
+		panes = append(panes, PaneInfo{
+			ID:      parts[0],
+			Dead:    parts[1] == "1",
+			Command: parts[2],
+			PID:     parts[3],
+		})
+	}
+	return panes
+}
+
+// PaneRunsAgent reports whether a pane of session is running the session's
+// agent, using the process names the session was started with.
+func (t *Tmux) PaneRunsAgent(session string, pane PaneInfo) bool {
+	if pane.Dead {
+		return false
+	}
+	return matchesPaneRuntime(pane.Command, pane.PID, t.resolveSessionProcessNames(session))
+}
+
+// RestartPane kills all processes in a pane and reruns the command the pane
+// was originally started with.
+func (t *Tmux) RestartPane(pane string) error {
+	_, err := t.run("respawn-pane", "-k", "-t", pane)
+	return err
+}
+
+// KillPane closes a pane, killing its processes.
+func (t *Tmux) KillPane(pane string) error {
+	_, err := t.run("kill-pane", "-t", pane)
+	return err
+}
+
 // GetPaneID returns the pane identifier for a session's first pane.
 // Returns a pane ID like "%0" that can be used with RespawnPane.
 // Targets pane 0 explicitly to be consistent with GetPaneCommand,
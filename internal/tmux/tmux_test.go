@@ -2206,3 +2206,22 @@ func TestValidateCommandBinary(t *testing.T) {
 		})
 	}
 }
+
+func TestParsePaneList(t *testing.T) {
+	out := "%1\t0\tclaude\t1234\n%2\t1\tbash\t1240\nmalformed\n\n%3\t0\tzsh\t1250\n"
+
+	got := parsePaneList(out)
+	want := []PaneInfo{
+		{ID: "%1", Command: "claude", PID: "1234"},
+		{ID: "%2", Command: "bash", PID: "1240", Dead: true},
+		{ID: "%3", Command: "zsh", PID: "1250"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("parsePaneList() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("pane %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
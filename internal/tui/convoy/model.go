@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os/exec"
-	"regexp"
 	"sort"
 	"sync"
 
@@ -16,11 +15,9 @@ import (
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/constants"
+	convoyops "github.com/steveyegge/gastown/internal/convoy"
 )
 
-// convoyIDPattern validates convoy IDs.
-var convoyIDPattern = regexp.MustCompile(`^hq-[a-zA-Z0-9-]+$`)
-
 // IssueItem represents a tracked issue within a convoy.
 type IssueItem struct {
 	ID     string
@@ -130,7 +127,7 @@ func loadConvoys(townBeads string) ([]ConvoyItem, error) {
 // loadTrackedIssues loads issues tracked by a convoy.
 func loadTrackedIssues(townBeads, convoyID string) ([]IssueItem, int, int) {
 	// Validate convoy ID for safety
-	if !convoyIDPattern.MatchString(convoyID) {
+	if !convoyops.ValidID(convoyID) {
 		return nil, 0, 0
 	}
 
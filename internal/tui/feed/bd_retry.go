@@ -0,0 +1,81 @@
+package feed
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// BdRetryPolicy bounds the retries of bd subprocess calls that fail because
+// the beads database is momentarily locked (e.g. during a SQLite WAL
+// checkpoint). Other failures are returned immediately.
+type BdRetryPolicy struct {
+	Attempts   int           // Total tries including the first; <= 1 disables retry
+	Backoff    time.Duration // Delay before the first retry, doubled after each
+	MaxBackoff time.Duration // Cap on the delay between retries (0 = no cap)
+}
+
+// DefaultBdRetryPolicy retries a locked database twice, waiting at most a
+// few hundred milliseconds in total so the feed never stalls for long.
+var DefaultBdRetryPolicy = BdRetryPolicy{
+	Attempts:   3,
+	Backoff:    100 * time.Millisecond,
+	MaxBackoff: time.Second,
+}
+
+var (
+	bdRetryMu     sync.RWMutex
+	bdRetryPolicy = DefaultBdRetryPolicy
+)
+
+// SetBdRetryPolicy replaces the retry policy used for the feed's bd calls.
+func SetBdRetryPolicy(p BdRetryPolicy) {
+	bdRetryMu.Lock()
+	defer bdRetryMu.Unlock()
+	bdRetryPolicy = p
+}
+
+func currentBdRetryPolicy() BdRetryPolicy {
+	bdRetryMu.RLock()
+	defer bdRetryMu.RUnlock()
+	return bdRetryPolicy
+}
+
+// runBd runs bd with args in dir and returns its stdout. A run that fails
+// with a locked database is retried per the current BdRetryPolicy; the last
+// error is returned once retries are exhausted or ctx is done.
+func runBd(ctx context.Context, dir string, args ...string) ([]byte, error) {
+	policy := currentBdRetryPolicy()
+	backoff := policy.Backoff
+
+	for attempt := 1; ; attempt++ {
+		cmd := exec.CommandContext(ctx, "bd", args...) //nolint:gosec // G204: args are constructed internally
+		cmd.Dir = dir
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+
+		err := cmd.Run()
+		if err == nil {
+			return stdout.Bytes(), nil
+		}
+		if attempt >= policy.Attempts || !errors.Is(beads.ClassifyStderr(stderr.String()), beads.ErrDBLocked) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if policy.MaxBackoff > 0 && backoff > policy.MaxBackoff {
+			backoff = policy.MaxBackoff
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// installFlakyBd puts a mock bd on PATH that fails with stderrMsg for the
+// first failures calls, then prints stdout. It returns a func reporting how
+// many times bd was run.
+func installFlakyBd(t *testing.T, failures int, stderrMsg, stdout string) func() int {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("mock bd requires sh")
+	}
+	binDir := t.TempDir()
+	counter := filepath.Join(binDir, "calls")
+	script := `#!/bin/sh
+n=$(cat "` + counter + `" 2>/dev/null || echo 0)
+n=$((n + 1))
+echo "$n" > "` + counter + `"
+if [ "$n" -le ` + strconv.Itoa(failures) + ` ]; then
+  echo '` + stderrMsg + `' >&2
+  exit 1
+fi
+echo '` + stdout + `'
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return func() int {
+		data, err := os.ReadFile(counter)
+		if err != nil {
+			return 0
+		}
+		n, _ := strconv.Atoi(strings.TrimSpace(string(data)))
+		return n
+	}
+}
+
+func fastBdRetry(t *testing.T, attempts int) {
+	t.Helper()
+	SetBdRetryPolicy(BdRetryPolicy{Attempts: attempts, Backoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond})
+	t.Cleanup(func() { SetBdRetryPolicy(DefaultBdRetryPolicy) })
+}
+
+func TestRunBd_RetriesLockedDatabase(t *testing.T) {
+	fastBdRetry(t, 3)
+	calls := installFlakyBd(t, 2, "Error: database is locked", `[]`)
+
+	out, err := runBd(context.Background(), t.TempDir(), "list", "--json")
+	if err != nil {
+		t.Fatalf("runBd: %v", err)
+	}
+	if strings.TrimSpace(string(out)) != "[]" {
+		t.Errorf("stdout = %q, want []", out)
+	}
+	if got := calls(); got != 3 {
+		t.Errorf("bd ran %d times, want 3 (two locked, one success)", got)
+	}
+}
+
+func TestRunBd_GivesUpAfterAttempts(t *testing.T) {
+	fastBdRetry(t, 2)
+	calls := installFlakyBd(t, 5, "Error: database is locked", `[]`)
+
+	if _, err := runBd(context.Background(), t.TempDir(), "list"); err == nil {
+		t.Fatal("expected error once retries are exhausted")
+	}
+	if got := calls(); got != 2 {
+		t.Errorf("bd ran %d times, want 2", got)
+	}
+}
+
+func TestRunBd_DoesNotRetryRealErrors(t *testing.T) {
+	fastBdRetry(t, 3)
+	calls := installFlakyBd(t, 1, "Error: unknown flag: --bogus", `[]`)
+
+	if _, err := runBd(context.Background(), t.TempDir(), "list", "--bogus"); err == nil {
+		t.Fatal("expected error")
+	}
+	if got := calls(); got != 1 {
+		t.Errorf("bd ran %d times, want 1 (non-lock errors are not retried)", got)
+	}
+}
+
+func TestFetchConvoys_LockedThenAvailable(t *testing.T) {
+	fastBdRetry(t, 3)
+	installFlakyBd(t, 1, "Error: database is locked", `[{"id":"hq-cv-1","title":"Ship it","status":"open"}]`)
+
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	state, err := FetchConvoys(townRoot, 0)
+	if err != nil {
+		t.Fatalf("FetchConvoys: %v", err)
+	}
+	if len(state.InProgress) != 1 || state.InProgress[0].ID != "hq-cv-1" {
+		t.Errorf("InProgress = %+v, want hq-cv-1 after the lock cleared", state.InProgress)
+	}
+}
+
+func TestRunBd_RecognizesLockedDatabase(t *testing.T) {
+	tests := []struct {
+		stderr string
+		want   bool
+	}{
+		{"Error: database is locked", true},
+		{"failed: SQLITE_BUSY (5)", true},
+		{"Error: Database Is Locked\n", true},
+		{"Error 1205 (HY000): Lock wait timeout exceeded; try restarting transaction", true},
+		{"Error: no such issue: hq-x", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := errors.Is(beads.ClassifyStderr(tt.stderr), beads.ErrDBLocked); got != tt.want {
+			t.Errorf("ClassifyStderr(%q) locked = %v, want %v", tt.stderr, got, tt.want)
+		}
+	}
+}
@@ -1,13 +1,13 @@
 package feed
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os/exec"
 	"path/filepath"
-	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -15,12 +15,12 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/rig"
 )
 
-// convoyIDPattern validates convoy IDs.
-var convoyIDPattern = regexp.MustCompile(`^hq-[a-zA-Z0-9-]+$`)
-
 // Convoy represents a convoy's status for the dashboard
 type Convoy struct {
 	ID        string    `json:"id"`
@@ -30,123 +30,355 @@ type Convoy struct {
 	Total     int       `json:"total"`
 	CreatedAt time.Time `json:"created_at"`
 	ClosedAt  time.Time `json:"closed_at,omitempty"`
+
+	// LandedAt is when the convoy's work landed: ClosedAt for a closed
+	// convoy, or when the last tracked issue closed for an open convoy
+	// whose tracked issues are all closed. Zero if the work has not landed.
+	LandedAt time.Time `json:"landed_at,omitempty"`
+
+	// Work state derived from tracked issues (see convoy.CalculateState)
+	WorkState    convoy.WorkState `json:"work_state,omitempty"`
+	StateReason  string           `json:"state_reason,omitempty"`
+	HasWorker    bool             `json:"has_worker"`
+	LastActivity time.Time        `json:"last_activity,omitempty"`
+
+	// Workers are the distinct assignees of open tracked issues, in the
+	// order first seen.
+	Workers []string `json:"workers,omitempty"`
+
+	// PinnedState and PinnedUntil are an operator override of WorkState set
+	// with gt convoy pin. The pin is ignored once PinnedUntil has passed.
+	PinnedState convoy.WorkState `json:"pinned_state,omitempty"`
+	PinnedUntil time.Time        `json:"pinned_until,omitempty"`
+
+	// TrackedError is set when the convoy's tracked issues could not be
+	// read, so its progress and work state are unknown rather than empty.
+	TrackedError string `json:"tracked_error,omitempty"`
 }
 
+// Progress returns the percentage of tracked issues that are closed, from 0
+// to 100. A convoy with no tracked issues has 0 progress.
+func (c Convoy) Progress() float64 {
+	if c.Total <= 0 {
+		return 0
+	}
+	return float64(c.Completed) * 100 / float64(c.Total)
+}
+
+// MarshalJSON adds the computed progress_percent field so consumers don't
+// each recompute completed/total.
+func (c Convoy) MarshalJSON() ([]byte, error) {
+	type Alias Convoy
+	return json.Marshal(struct {
+		Alias
+		ProgressPercent float64 `json:"progress_percent"`
+	}{
+		Alias:           Alias(c),
+		ProgressPercent: c.Progress(),
+	})
+}
+
+// DefaultLandedWindow is how far back the "recently landed" section looks
+// when no window is configured.
+const DefaultLandedWindow = 24 * time.Hour
+
 // ConvoyState holds all convoy data for the panel
 type ConvoyState struct {
 	InProgress []Convoy
 	Landed     []Convoy
 	LastUpdate time.Time
+
+	// LandedWindow is the lookback used to select Landed convoys.
+	LandedWindow time.Duration
+
+	// ToolingError is set when convoy data could not be fetched because a
+	// required binary is missing, so the panel can tell "tooling unavailable"
+	// apart from "no convoys".
+	ToolingError string
+
+	// ListError is set when the open convoys could not be listed for any
+	// other reason, so callers can tell a failed fetch apart from "no open
+	// convoys".
+	ListError string
+
+	// Draining lists rigs in drain mode (gt rig drain): their in-flight
+	// convoys continue but no new work is dispatched to them.
+	Draining []string
 }
 
-// FetchConvoys retrieves convoy status from town-level beads
-func FetchConvoys(townRoot string) (*ConvoyState, error) {
+// FetchConvoys retrieves convoy status from town-level beads. Convoys count
+// as landed when they landed within landedWindow (DefaultLandedWindow if zero
+// or negative). An open convoy whose tracked issues have all closed has
+// landed even though its bead is not closed yet; it stays in progress only
+// if it landed before the window.
+func FetchConvoys(townRoot string, landedWindow time.Duration) (*ConvoyState, error) {
+	return FetchConvoysContext(context.Background(), townRoot, landedWindow)
+}
+
+// FetchConvoysContext is FetchConvoys with a context. Cancelling ctx kills
+// any bd subprocess in flight and returns ctx's error.
+func FetchConvoysContext(ctx context.Context, townRoot string, landedWindow time.Duration) (*ConvoyState, error) {
 	townBeads := filepath.Join(townRoot, ".beads")
+	if landedWindow <= 0 {
+		landedWindow = DefaultLandedWindow
+	}
 
 	state := &ConvoyState{
-		InProgress: make([]Convoy, 0),
-		Landed:     make([]Convoy, 0),
-		LastUpdate: time.Now(),
+		InProgress:   make([]Convoy, 0),
+		Landed:       make([]Convoy, 0),
+		LastUpdate:   time.Now(),
+		LandedWindow: landedWindow,
+		Draining:     rig.DrainingRigs(townRoot),
 	}
 
 	// Fetch open convoys
-	openConvoys, err := listConvoys(townBeads, "open")
+	openConvoys, err := listConvoys(ctx, townBeads, "open")
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
 	if err != nil {
-		// Not a fatal error - just return empty state
+		// Not a fatal error - just return empty state, flagging a missing bd
+		if errors.Is(err, exec.ErrNotFound) {
+			state.ToolingError = "bd not found on PATH"
+		} else {
+			state.ListError = err.Error()
+		}
 		return state, nil
 	}
 
+	cutoff := time.Now().Add(-landedWindow)
 	for _, c := range openConvoys {
 		// Get detailed status for each convoy
-		convoy := enrichConvoy(townBeads, c)
-		state.InProgress = append(state.InProgress, convoy)
+		convoy := enrichConvoy(ctx, townBeads, c)
+		if !convoy.LandedAt.IsZero() && convoy.LandedAt.After(cutoff) {
+			state.Landed = append(state.Landed, convoy)
+		} else {
+			state.InProgress = append(state.InProgress, convoy)
+		}
 	}
 
-	// Fetch recently closed convoys (landed in last 24h)
-	closedConvoys, err := listConvoys(townBeads, "closed")
+	// Fetch recently closed convoys (landed within the window)
+	closedConvoys, err := listConvoys(ctx, townBeads, "closed")
 	if err == nil {
-		cutoff := time.Now().Add(-24 * time.Hour)
 		for _, c := range closedConvoys {
-			convoy := enrichConvoy(townBeads, c)
-			if !convoy.ClosedAt.IsZero() && convoy.ClosedAt.After(cutoff) {
+			convoy := enrichConvoy(ctx, townBeads, c)
+			if !convoy.LandedAt.IsZero() && convoy.LandedAt.After(cutoff) {
 				state.Landed = append(state.Landed, convoy)
 			}
 		}
 	}
 
-	// Sort: in-progress by created (oldest first), landed by closed (newest first)
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	// Sort: in-progress by created (oldest first), landed by landing (newest first)
 	sort.Slice(state.InProgress, func(i, j int) bool {
 		return state.InProgress[i].CreatedAt.Before(state.InProgress[j].CreatedAt)
 	})
 	sort.Slice(state.Landed, func(i, j int) bool {
-		return state.Landed[i].ClosedAt.After(state.Landed[j].ClosedAt)
+		return state.Landed[i].LandedAt.After(state.Landed[j].LandedAt)
 	})
 
 	return state, nil
 }
 
-// listConvoys returns convoys with the given status
-func listConvoys(beadsDir, status string) ([]convoyListItem, error) {
+// listConvoys returns convoys with the given status. Convoys archived by
+// gt convoy archive are left out.
+func listConvoys(ctx context.Context, beadsDir, status string) ([]convoyListItem, error) {
 	listArgs := []string{"list", "--type=convoy", "--status=" + status, "--json"}
 
-	ctx, cancel := context.WithTimeout(context.Background(), constants.BdSubprocessTimeout)
+	ctx, cancel := context.WithTimeout(ctx, constants.BdSubprocessTimeout)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, "bd", listArgs...) //nolint:gosec // G204: args are constructed internally
-	cmd.Dir = beadsDir
-	var stdout bytes.Buffer
-	cmd.Stdout = &stdout
-
-	if err := cmd.Run(); err != nil {
+	out, err := runBd(ctx, beadsDir, listArgs...)
+	if err != nil {
 		return nil, err
 	}
 
 	var items []convoyListItem
-	if err := json.Unmarshal(stdout.Bytes(), &items); err != nil {
+	if err := json.Unmarshal(out, &items); err != nil {
 		return nil, err
 	}
 
-	return items, nil
+	kept := items[:0]
+	for _, item := range items {
+		if !convoy.IsArchived(item.Labels) {
+			kept = append(kept, item)
+		}
+	}
+	return kept, nil
 }
 
 type convoyListItem struct {
-	ID        string `json:"id"`
-	Title     string `json:"title"`
-	Status    string `json:"status"`
-	CreatedAt string `json:"created_at"`
-	ClosedAt  string `json:"closed_at,omitempty"`
+	ID          string   `json:"id"`
+	Title       string   `json:"title"`
+	Description string   `json:"description,omitempty"`
+	Status      string   `json:"status"`
+	CreatedAt   string   `json:"created_at"`
+	ClosedAt    string   `json:"closed_at,omitempty"`
+	Labels      []string `json:"labels,omitempty"`
 }
 
-// enrichConvoy adds tracked issue counts to a convoy
-func enrichConvoy(beadsDir string, item convoyListItem) Convoy {
-	convoy := Convoy{
+// enrichConvoy adds tracked issue counts and work state to a convoy
+func enrichConvoy(ctx context.Context, beadsDir string, item convoyListItem) Convoy {
+	c := Convoy{
 		ID:     item.ID,
 		Title:  item.Title,
 		Status: item.Status,
 	}
+	c.CreatedAt, _ = beads.ParseTime(item.CreatedAt)
+	c.ClosedAt, _ = beads.ParseTime(item.ClosedAt)
+	applyConvoyPin(&c, item.Description)
 
-	// Parse timestamps
-	if t, err := time.Parse(time.RFC3339, item.CreatedAt); err == nil {
-		convoy.CreatedAt = t
-	} else if t, err := time.Parse("2006-01-02 15:04", item.CreatedAt); err == nil {
-		convoy.CreatedAt = t
+	// Get tracked issues and their status
+	tracked, err := getTrackedIssueStatus(ctx, beadsDir, item.ID)
+	if err != nil {
+		c.TrackedError = err.Error()
 	}
-	if t, err := time.Parse(time.RFC3339, item.ClosedAt); err == nil {
-		convoy.ClosedAt = t
-	} else if t, err := time.Parse("2006-01-02 15:04", item.ClosedAt); err == nil {
-		convoy.ClosedAt = t
+	applyTrackedIssues(&c, tracked)
+	if item.Status == "closed" {
+		c.LandedAt = c.ClosedAt
 	}
 
-	// Get tracked issues and their status
-	tracked := getTrackedIssueStatus(beadsDir, item.ID)
-	convoy.Total = len(tracked)
+	return c
+}
+
+// applyConvoyPin reads a gt convoy pin override from the convoy bead's
+// description fields. Malformed pins are ignored.
+func applyConvoyPin(c *Convoy, description string) {
+	fields := beads.ParseConvoyFields(&beads.Issue{Description: description})
+	if fields == nil || fields.PinnedState == "" {
+		return
+	}
+	state, ok := convoy.ParsePinnableState(fields.PinnedState)
+	if !ok {
+		return
+	}
+	until, err := time.Parse(time.RFC3339, fields.PinnedUntil)
+	if err != nil {
+		return
+	}
+	c.PinnedState = state
+	c.PinnedUntil = until
+}
+
+// applyTrackedIssues fills in progress counts and work state from a
+// convoy's tracked issues. When every tracked issue is closed, LandedAt is
+// set to the latest close time, falling back to the latest update for
+// issues without one. The convoy's worker is dead when every assigned open
+// issue has a dead worker, and it is blocked when every open issue is
+// blocked.
+func applyTrackedIssues(c *Convoy, tracked []trackedStatus) {
+	c.Total = len(tracked)
+	var landedAt time.Time
+	var open, blocked, assigned, deadWorkers int
 	for _, t := range tracked {
+		if t.Status != "closed" {
+			open++
+			if t.Status == "blocked" {
+				blocked++
+			}
+			if t.Assignee != "" {
+				assigned++
+				if t.WorkerDead {
+					deadWorkers++
+				}
+			}
+		}
 		if t.Status == "closed" {
-			convoy.Completed++
+			c.Completed++
+			closedAt := t.ClosedAt
+			if closedAt.IsZero() {
+				closedAt = t.UpdatedAt
+			}
+			if closedAt.After(landedAt) {
+				landedAt = closedAt
+			}
+		} else if t.Assignee != "" && !slices.Contains(c.Workers, t.Assignee) {
+			c.Workers = append(c.Workers, t.Assignee)
 		}
+		if t.UpdatedAt.After(c.LastActivity) {
+			c.LastActivity = t.UpdatedAt
+		}
+	}
+	c.HasWorker = len(c.Workers) > 0
+	if c.Total > 0 && c.Completed == c.Total {
+		c.LandedAt = landedAt
+	}
+
+	info := convoy.CalculateState(convoy.StateInput{
+		Completed:    c.Completed,
+		Total:        c.Total,
+		HasWorker:    c.HasWorker,
+		WorkerDead:   assigned > 0 && deadWorkers == assigned,
+		Blocked:      open > 0 && blocked == open,
+		LastActivity: c.LastActivity,
+		PinnedState:  c.PinnedState,
+		PinnedUntil:  c.PinnedUntil,
+	})
+	c.WorkState = info.State
+	c.StateReason = info.Reason
+}
+
+// formatLandedWindow formats the landed lookback for the section title:
+// whole days past a day as "7d", whole hours as "24h", otherwise Go duration
+// syntax. Zero means DefaultLandedWindow.
+func formatLandedWindow(d time.Duration) string {
+	if d <= 0 {
+		d = DefaultLandedWindow
+	}
+	switch {
+	case d > 24*time.Hour && d%(24*time.Hour) == 0:
+		return fmt.Sprintf("%dd", int(d/(24*time.Hour)))
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%dh", int(d/time.Hour))
+	default:
+		return d.String()
 	}
+}
 
-	return convoy
+// ConvoyHealth is a machine-readable summary of in-progress convoy states,
+// for deciding whether to raise an alert.
+type ConvoyHealth struct {
+	Active   int `json:"active"`
+	Idle     int `json:"idle"`
+	Waiting  int `json:"waiting"`
+	Stuck    int `json:"stuck"`
+	Complete int `json:"complete"`
+
+	// OldestStuck is the longest time since last activity among stuck
+	// convoys (zero when none are stuck).
+	OldestStuck time.Duration `json:"oldest_stuck,omitempty"`
+}
+
+// Health summarizes the work states of the in-progress convoys.
+func (s *ConvoyState) Health() ConvoyHealth {
+	var h ConvoyHealth
+	if s == nil {
+		return h
+	}
+	now := time.Now()
+	for _, c := range s.InProgress {
+		switch c.WorkState {
+		case convoy.WorkStateActive:
+			h.Active++
+		case convoy.WorkStateIdle:
+			h.Idle++
+		case convoy.WorkStateWaiting:
+			h.Waiting++
+		case convoy.WorkStateStuck:
+			h.Stuck++
+			if !c.LastActivity.IsZero() {
+				if d := now.Sub(c.LastActivity); d > h.OldestStuck {
+					h.OldestStuck = d
+				}
+			}
+		case convoy.WorkStateComplete:
+			h.Complete++
+		}
+	}
+	return h
 }
 
 // Convoy panel styles
@@ -179,6 +411,9 @@ var (
 
 	ConvoyAgeStyle = lipgloss.NewStyle().
 			Foreground(colorDim)
+
+	ConvoyWarningStyle = lipgloss.NewStyle().
+				Foreground(colorWarning)
 )
 
 // renderConvoyPanel renders the convoy status panel
@@ -203,10 +438,24 @@ func (m *Model) renderConvoys() string {
 
 	var lines []string
 
+	// When bd is unavailable, empty sections mean "unknown", not "none".
+	emptyText := func(text string) string {
+		if m.convoyState.ToolingError != "" {
+			text = "Unknown (tooling unavailable)"
+		}
+		return "  " + AgentIdleStyle.Render(text)
+	}
+	if m.convoyState.ToolingError != "" {
+		lines = append(lines, ConvoyWarningStyle.Render("⚠ Convoy status unavailable: "+m.convoyState.ToolingError), "")
+	}
+	if len(m.convoyState.Draining) > 0 {
+		lines = append(lines, ConvoyWarningStyle.Render("DRAINING: "+strings.Join(m.convoyState.Draining, ", ")+" (no new work)"), "")
+	}
+
 	// In Progress section
 	lines = append(lines, ConvoySectionStyle.Render("IN PROGRESS"))
 	if len(m.convoyState.InProgress) == 0 {
-		lines = append(lines, "  "+AgentIdleStyle.Render("No active convoys"))
+		lines = append(lines, emptyText("No active convoys"))
 	} else {
 		for _, c := range m.convoyState.InProgress {
 			lines = append(lines, renderConvoyLine(c, false))
@@ -216,9 +465,9 @@ func (m *Model) renderConvoys() string {
 	lines = append(lines, "")
 
 	// Recently Landed section
-	lines = append(lines, ConvoySectionStyle.Render("RECENTLY LANDED (24h)"))
+	lines = append(lines, ConvoySectionStyle.Render("RECENTLY LANDED ("+formatLandedWindow(m.convoyState.LandedWindow)+")"))
 	if len(m.convoyState.Landed) == 0 {
-		lines = append(lines, "  "+AgentIdleStyle.Render("No recent landings"))
+		lines = append(lines, emptyText("No recent landings"))
 	} else {
 		for _, c := range m.convoyState.Landed {
 			lines = append(lines, renderConvoyLine(c, true))
@@ -230,20 +479,18 @@ func (m *Model) renderConvoys() string {
 
 // renderConvoyLine renders a single convoy status line
 func renderConvoyLine(c Convoy, landed bool) string {
-	// Format: "  hq-xyz  Title       2/4 ●●○○" or "  hq-xyz  Title       ✓ 2h ago"
+	// Format: "  hq-xyz  Title       2/4 ●●○○  active 4m ago" or "  hq-xyz  Title       ✓ 2h ago"
 	id := ConvoyIDStyle.Render(c.ID)
 
-	// Truncate title if too long (rune-safe to avoid splitting multi-byte UTF-8)
-	title := c.Title
-	if utf8.RuneCountInString(title) > 20 {
-		runes := []rune(title)
-		title = string(runes[:17]) + "..."
-	}
-	title = ConvoyNameStyle.Render(title)
+	title := ConvoyNameStyle.Render(truncateConvoyTitle(c.Title))
 
 	if landed {
 		// Show checkmark and time since landing
-		age := formatAge(time.Since(c.ClosedAt))
+		landedAt := c.LandedAt
+		if landedAt.IsZero() {
+			landedAt = c.ClosedAt
+		}
+		age := formatAge(time.Since(landedAt))
 		status := ConvoyLandedStyle.Render("✓") + " " + ConvoyAgeStyle.Render(age+" ago")
 		return fmt.Sprintf("  %s  %-20s  %s", id, title, status)
 	}
@@ -251,7 +498,62 @@ func renderConvoyLine(c Convoy, landed bool) string {
 	// Show progress bar
 	progress := renderProgressBar(c.Completed, c.Total)
 	count := ConvoyProgressStyle.Render(fmt.Sprintf("%d/%d", c.Completed, c.Total))
-	return fmt.Sprintf("  %s  %-20s  %s %s", id, title, count, progress)
+	line := fmt.Sprintf("  %s  %-20s  %s %s", id, title, count, progress)
+	if workers := formatConvoyWorkers(c.Workers); workers != "" {
+		line += "  " + ConvoyAgeStyle.Render("→ "+workers)
+	}
+	if !c.LastActivity.IsZero() {
+		line += "  " + ConvoyAgeStyle.Render("active "+formatActivityAge(time.Since(c.LastActivity))+" ago")
+	}
+	return line
+}
+
+// formatActivityAge formats the time since a convoy's last activity. Unlike
+// formatAge it keeps second granularity under a minute, so a convoy touched
+// moments ago is distinguishable from one that has been quiet for a while.
+func formatActivityAge(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	if d < time.Minute {
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	}
+	return formatAge(d)
+}
+
+// maxConvoyWorkersShown caps how many worker names a convoy line lists.
+const maxConvoyWorkersShown = 2
+
+// formatConvoyWorkers renders worker names compactly: the last path segment
+// of each assignee ("gastown/polecats/nux" → "nux"), at most
+// maxConvoyWorkersShown of them, then "+N" for the rest.
+func formatConvoyWorkers(workers []string) string {
+	if len(workers) == 0 {
+		return ""
+	}
+	shown := workers
+	if len(shown) > maxConvoyWorkersShown {
+		shown = shown[:maxConvoyWorkersShown]
+	}
+	names := make([]string, 0, len(shown))
+	for _, w := range shown {
+		names = append(names, w[strings.LastIndex(w, "/")+1:])
+	}
+	out := strings.Join(names, ", ")
+	if extra := len(workers) - len(shown); extra > 0 {
+		out += fmt.Sprintf(" +%d", extra)
+	}
+	return out
+}
+
+// truncateConvoyTitle shortens a title to 20 runes (rune-safe to avoid
+// splitting multi-byte UTF-8).
+func truncateConvoyTitle(title string) string {
+	if utf8.RuneCountInString(title) > 20 {
+		runes := []rune(title)
+		return string(runes[:17]) + "..."
+	}
+	return title
 }
 
 // renderProgressBar creates a simple progress bar: ●●○○
@@ -259,6 +561,14 @@ func renderProgressBar(completed, total int) string {
 	if total == 0 {
 		return ""
 	}
+	return ConvoyProgressStyle.Render(progressDots(completed, total))
+}
+
+// progressDots returns an unstyled progress bar, capped at 5 dots.
+func progressDots(completed, total int) string {
+	if total == 0 {
+		return ""
+	}
 
 	// Cap at 5 dots for display
 	displayTotal := total
@@ -271,6 +581,37 @@ func renderProgressBar(completed, total int) string {
 		filled = displayTotal
 	}
 
-	bar := strings.Repeat("●", filled) + strings.Repeat("○", displayTotal-filled)
-	return ConvoyProgressStyle.Render(bar)
+	return strings.Repeat("●", filled) + strings.Repeat("○", displayTotal-filled)
+}
+
+// ConvoySummaryText returns a plain-text (ANSI-free) summary of the
+// in-progress convoys with their work states and progress, suitable for
+// pasting into chat.
+func ConvoySummaryText(state *ConvoyState) string {
+	if state == nil {
+		return "Convoy status unavailable\n"
+	}
+
+	var b strings.Builder
+	if state.ToolingError != "" {
+		fmt.Fprintf(&b, "Convoy status unavailable: %s\n", state.ToolingError)
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "Convoys in progress: %d\n", len(state.InProgress))
+	for _, c := range state.InProgress {
+		line := fmt.Sprintf("  %s  %-20s  %d/%d %s", c.ID, truncateConvoyTitle(c.Title),
+			c.Completed, c.Total, progressDots(c.Completed, c.Total))
+		if c.WorkState != "" {
+			line += "  " + string(c.WorkState)
+			if c.StateReason != "" {
+				line += " (" + c.StateReason + ")"
+			}
+		}
+		if workers := formatConvoyWorkers(c.Workers); workers != "" {
+			line += "  → " + workers
+		}
+		b.WriteString(strings.TrimRight(line, " ") + "\n")
+	}
+	return b.String()
 }
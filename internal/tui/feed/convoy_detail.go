@@ -0,0 +1,81 @@
+package feed
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// TrackedIssue is one issue tracked by a convoy, as shown by gt convoy show.
+type TrackedIssue struct {
+	ID           string    `json:"id"`
+	Title        string    `json:"title"`
+	Status       string    `json:"status"`
+	Assignee     string    `json:"assignee,omitempty"`
+	LastActivity time.Time `json:"last_activity,omitempty"`
+}
+
+// ConvoyDetail is a single convoy with its tracked issues listed.
+type ConvoyDetail struct {
+	Convoy Convoy         `json:"convoy"`
+	Issues []TrackedIssue `json:"issues"`
+}
+
+// FetchConvoy retrieves one convoy from town-level beads along with the
+// current status of each tracked issue. Work state, progress, and workers are
+// derived the same way as for the convoy panel.
+func FetchConvoy(townRoot, convoyID string) (*ConvoyDetail, error) {
+	townBeads := filepath.Join(townRoot, ".beads")
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.BdSubprocessTimeout)
+	defer cancel()
+
+	out, err := runBd(ctx, townBeads, "show", convoyID, "--json")
+	if err != nil {
+		return nil, fmt.Errorf("convoy %s not found", convoyID)
+	}
+
+	var items []struct {
+		convoyListItem
+		IssueType string `json:"issue_type"`
+	}
+	if err := json.Unmarshal(out, &items); err != nil {
+		return nil, fmt.Errorf("parsing convoy %s: %w", convoyID, err)
+	}
+	if len(items) == 0 || (items[0].IssueType != "" && items[0].IssueType != "convoy") {
+		return nil, fmt.Errorf("convoy %s not found", convoyID)
+	}
+	item := items[0].convoyListItem
+
+	c := Convoy{
+		ID:     item.ID,
+		Title:  item.Title,
+		Status: item.Status,
+	}
+	c.CreatedAt, _ = beads.ParseTime(item.CreatedAt)
+	c.ClosedAt, _ = beads.ParseTime(item.ClosedAt)
+	applyConvoyPin(&c, item.Description)
+
+	tracked, _ := getTrackedIssueStatus(ctx, townBeads, item.ID)
+	applyTrackedIssues(&c, tracked)
+	if item.Status == "closed" {
+		c.LandedAt = c.ClosedAt
+	}
+
+	detail := &ConvoyDetail{Convoy: c, Issues: make([]TrackedIssue, 0, len(tracked))}
+	for _, t := range tracked {
+		detail.Issues = append(detail.Issues, TrackedIssue{
+			ID:           t.ID,
+			Title:        t.Title,
+			Status:       t.Status,
+			Assignee:     t.Assignee,
+			LastActivity: t.UpdatedAt,
+		})
+	}
+	return detail, nil
+}
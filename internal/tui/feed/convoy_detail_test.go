@@ -0,0 +1,118 @@
+package feed
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/convoy"
+)
+
+// installConvoyDetailBd puts a mock bd on PATH that knows one convoy,
+// hq-cv-abc, tracking gt-a (closed) and gt-b (in progress).
+func installConvoyDetailBd(t *testing.T, active time.Time) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("mock bd requires sh")
+	}
+	convoyJSON := `[{"id":"hq-cv-abc","title":"Auth rework","status":"open","issue_type":"convoy","created_at":"2026-01-02T15:04:05Z"}]`
+	depsJSON := `[{"id":"gt-a","title":"Login","status":"open"},{"id":"gt-b","title":"Logout","status":"open"}]`
+	issuesJSON := `[{"id":"gt-a","title":"Login","status":"closed","assignee":"gastown/polecats/nux"},` +
+		`{"id":"gt-b","title":"Logout","status":"in_progress","assignee":"gastown/polecats/toast","updated_at":"` + active.Format(time.RFC3339) + `"}]`
+
+	binDir := t.TempDir()
+	script := "#!/bin/sh\ncase \"$*\" in\n" +
+		"  'show hq-cv-abc --json') echo '" + convoyJSON + "' ;;\n" +
+		"  'dep list hq-cv-abc'*) echo '" + depsJSON + "' ;;\n" +
+		"  'show gt-a gt-b --json') echo '" + issuesJSON + "' ;;\n" +
+		"  show*) echo 'Error: no issue found' >&2; exit 1 ;;\n" +
+		"  *) echo '[]' ;;\nesac\n"
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir)
+}
+
+func newConvoyDetailTown(t *testing.T) string {
+	t.Helper()
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return townRoot
+}
+
+func TestFetchConvoy(t *testing.T) {
+	active := time.Now().Add(-5 * time.Minute).Truncate(time.Second)
+	installConvoyDetailBd(t, active)
+
+	detail, err := FetchConvoy(newConvoyDetailTown(t), "hq-cv-abc")
+	if err != nil {
+		t.Fatalf("FetchConvoy: %v", err)
+	}
+
+	c := detail.Convoy
+	if c.ID != "hq-cv-abc" || c.Title != "Auth rework" {
+		t.Errorf("Convoy = %s %q", c.ID, c.Title)
+	}
+	if c.Completed != 1 || c.Total != 2 {
+		t.Errorf("progress = %d/%d, want 1/2", c.Completed, c.Total)
+	}
+	if len(c.Workers) != 1 || c.Workers[0] != "gastown/polecats/toast" {
+		t.Errorf("Workers = %v", c.Workers)
+	}
+	if !c.LastActivity.Equal(active) {
+		t.Errorf("LastActivity = %v, want %v", c.LastActivity, active)
+	}
+	if c.WorkState == "" {
+		t.Error("WorkState not set")
+	}
+
+	if len(detail.Issues) != 2 {
+		t.Fatalf("Issues = %+v, want 2", detail.Issues)
+	}
+	b := detail.Issues[1]
+	if b.ID != "gt-b" || b.Title != "Logout" || b.Status != "in_progress" || b.Assignee != "gastown/polecats/toast" {
+		t.Errorf("Issues[1] = %+v", b)
+	}
+	if !b.LastActivity.Equal(active) {
+		t.Errorf("Issues[1].LastActivity = %v, want %v", b.LastActivity, active)
+	}
+}
+
+func TestFetchConvoy_DeadWorkerIsStuck(t *testing.T) {
+	installConvoyDetailBd(t, time.Now().Add(-time.Minute))
+	orig := workerSessionDead
+	t.Cleanup(func() { workerSessionDead = orig })
+	var checked []string
+	workerSessionDead = func(assignee string) bool {
+		checked = append(checked, assignee)
+		return assignee == "gastown/polecats/toast"
+	}
+
+	detail, err := FetchConvoy(newConvoyDetailTown(t), "hq-cv-abc")
+	if err != nil {
+		t.Fatalf("FetchConvoy: %v", err)
+	}
+
+	c := detail.Convoy
+	if c.WorkState != convoy.WorkStateStuck || c.StateReason != "assigned worker is not running" {
+		t.Errorf("state = %s (%s), want stuck with dead worker", c.WorkState, c.StateReason)
+	}
+	// Closed issues are never checked: their worker is expected to be gone.
+	if len(checked) != 1 || checked[0] != "gastown/polecats/toast" {
+		t.Errorf("checked = %v, want only the open issue's worker", checked)
+	}
+}
+
+func TestFetchConvoy_Unknown(t *testing.T) {
+	installConvoyDetailBd(t, time.Now())
+
+	_, err := FetchConvoy(newConvoyDetailTown(t), "hq-cv-nope")
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("FetchConvoy(unknown) error = %v, want not found", err)
+	}
+}
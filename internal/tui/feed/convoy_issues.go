@@ -1,45 +1,73 @@
 package feed
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
-	"os/exec"
+	"fmt"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
 )
 
 type trackedStatus struct {
-	ID     string
-	Status string
+	ID        string
+	Title     string
+	Status    string
+	Assignee  string
+	UpdatedAt time.Time
+	ClosedAt  time.Time
+
+	// WorkerDead is true when the issue is open and its assignee is known
+	// to have no tmux session.
+	WorkerDead bool
 }
 
+// workerSessionDead reports whether an assignee is known to have no live
+// tmux session. Unparseable assignees and tmux errors count as alive, so a
+// convoy is never flagged stuck on missing data alone.
+// Tests override this variable to avoid tmux.
+var workerSessionDead = func(assignee string) bool {
+	id, err := session.ParseAddress(assignee)
+	if err != nil {
+		return false
+	}
+	exists, err := tmux.NewTmux().HasSession(id.SessionName())
+	return err == nil && !exists
+}
 
-// getTrackedIssueStatus queries tracked issues and their status.
-func getTrackedIssueStatus(beadsDir, convoyID string) []trackedStatus {
-	if !convoyIDPattern.MatchString(convoyID) {
-		return nil
+// trackedIssueJSON is the subset of bd issue JSON used for tracked issues.
+type trackedIssueJSON struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	Status    string `json:"status"`
+	Assignee  string `json:"assignee"`
+	UpdatedAt string `json:"updated_at"`
+	ClosedAt  string `json:"closed_at,omitempty"`
+}
+
+// getTrackedIssueStatus queries tracked issues and their status. It returns
+// an error when the tracked issues could not be listed at all.
+func getTrackedIssueStatus(ctx context.Context, beadsDir, convoyID string) ([]trackedStatus, error) {
+	if !convoy.ValidID(convoyID) {
+		return nil, nil
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), constants.BdSubprocessTimeout)
+	ctx, cancel := context.WithTimeout(ctx, constants.BdSubprocessTimeout)
 	defer cancel()
 
 	// Query tracked issues using bd dep list (returns full issue details)
-	cmd := exec.CommandContext(ctx, "bd", "dep", "list", convoyID, "-t", "tracks", "--json")
-	cmd.Dir = beadsDir
-	var stdout bytes.Buffer
-	cmd.Stdout = &stdout
-	if err := cmd.Run(); err != nil {
-		return nil
+	out, err := runBd(ctx, beadsDir, "dep", "list", convoyID, "-t", "tracks", "--json")
+	if err != nil {
+		return nil, fmt.Errorf("listing tracked issues of %s: %w", convoyID, err)
 	}
 
-	var deps []struct {
-		ID     string `json:"id"`
-		Status string `json:"status"`
-	}
-	if err := json.Unmarshal(stdout.Bytes(), &deps); err != nil {
-		return nil
+	var deps []trackedIssueJSON
+	if err := json.Unmarshal(out, &deps); err != nil {
+		return nil, fmt.Errorf("parsing tracked issues of %s: %w", convoyID, err)
 	}
 
 	// Extract raw issue IDs
@@ -47,28 +75,43 @@ func getTrackedIssueStatus(beadsDir, convoyID string) []trackedStatus {
 		deps[i].ID = beads.ExtractIssueID(deps[i].ID)
 	}
 
-	// Refresh status via cross-rig lookup. bd dep list returns status from
+	// Refresh status and assignment via cross-rig lookup. bd dep list returns status from
 	// the dependency record in HQ beads which is never updated when cross-rig
 	// issues (e.g., gt-* tracked by hq-* convoys) are closed in their rig.
-	freshStatus := refreshTrackedStatus(ctx, deps)
+	fresh := refreshTrackedStatus(ctx, deps)
 
 	var tracked []trackedStatus
+	dead := make(map[string]bool)
 	for _, dep := range deps {
-		status := dep.Status
-		if fresh, ok := freshStatus[dep.ID]; ok {
-			status = fresh
+		if f, ok := fresh[dep.ID]; ok {
+			dep = f
+		}
+		updatedAt, _ := beads.ParseTime(dep.UpdatedAt)
+		closedAt, _ := beads.ParseTime(dep.ClosedAt)
+		t := trackedStatus{
+			ID:        dep.ID,
+			Title:     dep.Title,
+			Status:    dep.Status,
+			Assignee:  dep.Assignee,
+			UpdatedAt: updatedAt,
+			ClosedAt:  closedAt,
 		}
-		tracked = append(tracked, trackedStatus{ID: dep.ID, Status: status})
+		if t.Status != "closed" && t.Assignee != "" {
+			isDead, checked := dead[t.Assignee]
+			if !checked {
+				isDead = workerSessionDead(t.Assignee)
+				dead[t.Assignee] = isDead
+			}
+			t.WorkerDead = isDead
+		}
+		tracked = append(tracked, t)
 	}
 
-	return tracked
+	return tracked, nil
 }
 
 // refreshTrackedStatus does a batch bd show to get current status for tracked issues.
-func refreshTrackedStatus(ctx context.Context, deps []struct {
-	ID     string `json:"id"`
-	Status string `json:"status"`
-}) map[string]string {
+func refreshTrackedStatus(ctx context.Context, deps []trackedIssueJSON) map[string]trackedIssueJSON {
 	if len(deps) == 0 {
 		return nil
 	}
@@ -79,25 +122,19 @@ func refreshTrackedStatus(ctx context.Context, deps []struct {
 	}
 	args = append(args, "--json")
 
-	cmd := exec.CommandContext(ctx, "bd", args...)
-	var stdout bytes.Buffer
-	cmd.Stdout = &stdout
-
-	if err := cmd.Run(); err != nil {
+	out, err := runBd(ctx, "", args...)
+	if err != nil {
 		return nil
 	}
 
-	var issues []struct {
-		ID     string `json:"id"`
-		Status string `json:"status"`
-	}
-	if err := json.Unmarshal(stdout.Bytes(), &issues); err != nil {
+	var issues []trackedIssueJSON
+	if err := json.Unmarshal(out, &issues); err != nil {
 		return nil
 	}
 
-	result := make(map[string]string, len(issues))
+	result := make(map[string]trackedIssueJSON, len(issues))
 	for _, issue := range issues {
-		result[issue.ID] = issue.Status
+		result[issue.ID] = issue
 	}
 	return result
 }
@@ -0,0 +1,701 @@
+package feed
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/convoy"
+)
+
+func TestApplyTrackedIssues(t *testing.T) {
+	now := time.Now()
+	var c Convoy
+	applyTrackedIssues(&c, []trackedStatus{
+		{ID: "gt-a", Status: "closed", Assignee: "gastown/polecats/nux", UpdatedAt: now.Add(-2 * time.Hour)},
+		{ID: "gt-b", Status: "in_progress", Assignee: "gastown/polecats/toast", UpdatedAt: now.Add(-time.Minute)},
+		{ID: "gt-c", Status: "open"},
+	})
+
+	if c.Completed != 1 || c.Total != 3 {
+		t.Errorf("progress = %d/%d, want 1/3", c.Completed, c.Total)
+	}
+	if !c.HasWorker {
+		t.Error("HasWorker = false, want true")
+	}
+	if !c.LastActivity.Equal(now.Add(-time.Minute)) {
+		t.Errorf("LastActivity = %v, want most recent update", c.LastActivity)
+	}
+	if c.WorkState != convoy.WorkStateActive {
+		t.Errorf("WorkState = %s, want active", c.WorkState)
+	}
+}
+
+func TestApplyTrackedIssues_WorkerDeadAndBlocked(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		tracked    []trackedStatus
+		wantState  convoy.WorkState
+		wantReason string
+	}{
+		{
+			name: "all workers dead",
+			tracked: []trackedStatus{
+				{ID: "gt-a", Status: "in_progress", Assignee: "gastown/polecats/nux", UpdatedAt: now, WorkerDead: true},
+				{ID: "gt-b", Status: "closed", Assignee: "gastown/polecats/toast"},
+			},
+			wantState:  convoy.WorkStateStuck,
+			wantReason: "assigned worker is not running",
+		},
+		{
+			name: "one worker still alive",
+			tracked: []trackedStatus{
+				{ID: "gt-a", Status: "in_progress", Assignee: "gastown/polecats/nux", UpdatedAt: now, WorkerDead: true},
+				{ID: "gt-b", Status: "in_progress", Assignee: "gastown/polecats/toast", UpdatedAt: now},
+			},
+			wantState: convoy.WorkStateActive,
+		},
+		{
+			name: "all open work blocked",
+			tracked: []trackedStatus{
+				{ID: "gt-a", Status: "blocked", Assignee: "gastown/polecats/nux", UpdatedAt: now},
+				{ID: "gt-b", Status: "closed"},
+			},
+			wantState:  convoy.WorkStateWaiting,
+			wantReason: "blocked on gate or dependency",
+		},
+		{
+			name: "some open work unblocked",
+			tracked: []trackedStatus{
+				{ID: "gt-a", Status: "blocked"},
+				{ID: "gt-b", Status: "in_progress", Assignee: "gastown/polecats/nux", UpdatedAt: now},
+			},
+			wantState: convoy.WorkStateActive,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c Convoy
+			applyTrackedIssues(&c, tt.tracked)
+			if c.WorkState != tt.wantState || c.StateReason != tt.wantReason {
+				t.Errorf("state = %s (%q), want %s (%q)", c.WorkState, c.StateReason, tt.wantState, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestApplyConvoyPin(t *testing.T) {
+	now := time.Now()
+	tracked := []trackedStatus{
+		{ID: "gt-a", Status: "in_progress", Assignee: "gastown/polecats/nux", UpdatedAt: now.Add(-2 * time.Hour)},
+	}
+	pinDesc := func(until time.Time) string {
+		return "Long build\npinned_state: active\npinned_until: " + until.UTC().Format(time.RFC3339)
+	}
+
+	tests := []struct {
+		name        string
+		description string
+		want        convoy.WorkState
+	}{
+		{"unpinned", "Long build", convoy.WorkStateStuck},
+		{"pinned overrides computed state", pinDesc(now.Add(time.Hour)), convoy.WorkStateActive},
+		{"expired pin reverts", pinDesc(now.Add(-time.Minute)), convoy.WorkStateStuck},
+		{"unpinnable state ignored", "pinned_state: complete\npinned_until: " + now.Add(time.Hour).UTC().Format(time.RFC3339), convoy.WorkStateStuck},
+		{"malformed expiry ignored", "pinned_state: active\npinned_until: tomorrow", convoy.WorkStateStuck},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c Convoy
+			applyConvoyPin(&c, tt.description)
+			applyTrackedIssues(&c, tracked)
+			if c.WorkState != tt.want {
+				t.Errorf("WorkState = %s (%s), want %s", c.WorkState, c.StateReason, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyTrackedIssues_Workers(t *testing.T) {
+	tests := []struct {
+		name    string
+		tracked []trackedStatus
+		want    []string
+	}{
+		{
+			name:    "no workers",
+			tracked: []trackedStatus{{ID: "gt-a", Status: "open"}},
+			want:    nil,
+		},
+		{
+			name: "single worker",
+			tracked: []trackedStatus{
+				{ID: "gt-a", Status: "in_progress", Assignee: "gastown/polecats/nux"},
+				{ID: "gt-b", Status: "hooked", Assignee: "gastown/polecats/nux"},
+			},
+			want: []string{"gastown/polecats/nux"},
+		},
+		{
+			name: "multiple workers, closed assignee ignored",
+			tracked: []trackedStatus{
+				{ID: "gt-a", Status: "in_progress", Assignee: "gastown/polecats/nux"},
+				{ID: "gt-b", Status: "closed", Assignee: "gastown/polecats/slit"},
+				{ID: "gt-c", Status: "in_progress", Assignee: "beads/polecats/toast"},
+			},
+			want: []string{"gastown/polecats/nux", "beads/polecats/toast"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c Convoy
+			applyTrackedIssues(&c, tt.tracked)
+			if !slices.Equal(c.Workers, tt.want) {
+				t.Errorf("Workers = %v, want %v", c.Workers, tt.want)
+			}
+			if c.HasWorker != (len(tt.want) > 0) {
+				t.Errorf("HasWorker = %v, want %v", c.HasWorker, len(tt.want) > 0)
+			}
+		})
+	}
+}
+
+func TestApplyTrackedIssues_LandedAt(t *testing.T) {
+	now := time.Now()
+
+	var open Convoy
+	applyTrackedIssues(&open, []trackedStatus{
+		{ID: "gt-a", Status: "closed", ClosedAt: now.Add(-time.Hour)},
+		{ID: "gt-b", Status: "open"},
+	})
+	if !open.LandedAt.IsZero() {
+		t.Errorf("LandedAt = %v with open tracked issues, want zero", open.LandedAt)
+	}
+
+	var landed Convoy
+	applyTrackedIssues(&landed, []trackedStatus{
+		{ID: "gt-a", Status: "closed", ClosedAt: now.Add(-3 * time.Hour)},
+		{ID: "gt-b", Status: "closed", ClosedAt: now.Add(-time.Hour), UpdatedAt: now},
+		{ID: "gt-c", Status: "closed", UpdatedAt: now.Add(-2 * time.Hour)},
+	})
+	if !landed.LandedAt.Equal(now.Add(-time.Hour)) {
+		t.Errorf("LandedAt = %v, want latest close time %v", landed.LandedAt, now.Add(-time.Hour))
+	}
+
+	var empty Convoy
+	applyTrackedIssues(&empty, nil)
+	if !empty.LandedAt.IsZero() {
+		t.Errorf("LandedAt = %v with no tracked issues, want zero", empty.LandedAt)
+	}
+}
+
+func TestFormatConvoyWorkers(t *testing.T) {
+	tests := []struct {
+		workers []string
+		want    string
+	}{
+		{nil, ""},
+		{[]string{"gastown/polecats/nux"}, "nux"},
+		{[]string{"gastown/polecats/nux", "beads/polecats/toast"}, "nux, toast"},
+		{[]string{"gastown/polecats/nux", "gastown/polecats/toast", "gastown/polecats/slit", "mayor"}, "nux, toast +2"},
+	}
+	for _, tt := range tests {
+		if got := formatConvoyWorkers(tt.workers); got != tt.want {
+			t.Errorf("formatConvoyWorkers(%v) = %q, want %q", tt.workers, got, tt.want)
+		}
+	}
+}
+
+func TestConvoyStateHealth(t *testing.T) {
+	now := time.Now()
+	state := &ConvoyState{
+		InProgress: []Convoy{
+			{ID: "hq-1", WorkState: convoy.WorkStateActive, LastActivity: now},
+			{ID: "hq-2", WorkState: convoy.WorkStateActive, LastActivity: now},
+			{ID: "hq-3", WorkState: convoy.WorkStateIdle, LastActivity: now.Add(-10 * time.Minute)},
+			{ID: "hq-4", WorkState: convoy.WorkStateWaiting},
+			{ID: "hq-5", WorkState: convoy.WorkStateStuck, LastActivity: now.Add(-45 * time.Minute)},
+			{ID: "hq-6", WorkState: convoy.WorkStateStuck, LastActivity: now.Add(-3 * time.Hour)},
+			{ID: "hq-7", WorkState: convoy.WorkStateComplete},
+		},
+		// Landed convoys are not part of the health summary.
+		Landed: []Convoy{{ID: "hq-8", WorkState: convoy.WorkStateComplete}},
+	}
+
+	h := state.Health()
+	if h.Active != 2 || h.Idle != 1 || h.Waiting != 1 || h.Stuck != 2 || h.Complete != 1 {
+		t.Errorf("Health() counts = %+v", h)
+	}
+	if h.OldestStuck < 3*time.Hour || h.OldestStuck > 3*time.Hour+time.Minute {
+		t.Errorf("OldestStuck = %s, want ~3h", h.OldestStuck)
+	}
+}
+
+func TestConvoyStateHealth_Empty(t *testing.T) {
+	var nilState *ConvoyState
+	if h := nilState.Health(); h != (ConvoyHealth{}) {
+		t.Errorf("nil state Health() = %+v, want zero", h)
+	}
+	if h := (&ConvoyState{}).Health(); h.OldestStuck != 0 || h.Stuck != 0 {
+		t.Errorf("empty state Health() = %+v, want zero", h)
+	}
+}
+
+func TestFetchConvoys_BdMissing(t *testing.T) {
+	t.Setenv("PATH", t.TempDir()) // no bd anywhere on PATH
+
+	state, err := FetchConvoys(t.TempDir(), 0)
+	if err != nil {
+		t.Fatalf("FetchConvoys should degrade gracefully, got %v", err)
+	}
+	if state.ToolingError == "" {
+		t.Error("expected ToolingError when bd is missing")
+	}
+	if len(state.InProgress) != 0 || len(state.Landed) != 0 {
+		t.Errorf("expected empty state, got %+v", state)
+	}
+}
+
+func TestFetchConvoys_NoConvoys(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("mock bd requires sh")
+	}
+	binDir := t.TempDir()
+	script := "#!/bin/sh\necho '[]'\n"
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir)
+
+	state, err := FetchConvoys(t.TempDir(), 0)
+	if err != nil {
+		t.Fatalf("FetchConvoys: %v", err)
+	}
+	if state.ToolingError != "" {
+		t.Errorf("ToolingError = %q, want empty when bd works", state.ToolingError)
+	}
+}
+
+func TestFetchConvoys_LandedWindow(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("mock bd requires sh")
+	}
+	now := time.Now()
+	closed := func(id string, ago time.Duration) string {
+		return `{"id":"` + id + `","title":"` + id + `","status":"closed","closed_at":"` + now.Add(-ago).Format(time.RFC3339) + `"}`
+	}
+	closedJSON := "[" + strings.Join([]string{
+		closed("hq-cv-1h", time.Hour),
+		closed("hq-cv-2d", 2*24*time.Hour),
+		closed("hq-cv-10d", 10*24*time.Hour),
+	}, ",") + "]"
+
+	binDir := t.TempDir()
+	script := "#!/bin/sh\ncase \"$*\" in\n  *--status=closed*) echo '" + closedJSON + "' ;;\n  *) echo '[]' ;;\nesac\n"
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir)
+
+	tests := []struct {
+		name   string
+		window time.Duration
+		want   []string
+	}{
+		{"default window", 0, []string{"hq-cv-1h"}},
+		{"one week", 7 * 24 * time.Hour, []string{"hq-cv-1h", "hq-cv-2d"}},
+		{"thirty days", 30 * 24 * time.Hour, []string{"hq-cv-1h", "hq-cv-2d", "hq-cv-10d"}},
+		{"thirty minutes", 30 * time.Minute, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			townRoot := t.TempDir()
+			if err := os.MkdirAll(filepath.Join(townRoot, ".beads"), 0755); err != nil {
+				t.Fatal(err)
+			}
+			state, err := FetchConvoys(townRoot, tt.window)
+			if err != nil {
+				t.Fatalf("FetchConvoys: %v", err)
+			}
+			var got []string
+			for _, c := range state.Landed {
+				got = append(got, c.ID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Landed = %v, want %v", got, tt.want)
+			}
+			wantWindow := tt.window
+			if wantWindow == 0 {
+				wantWindow = DefaultLandedWindow
+			}
+			if state.LandedWindow != wantWindow {
+				t.Errorf("LandedWindow = %s, want %s", state.LandedWindow, wantWindow)
+			}
+		})
+	}
+}
+
+func TestFetchConvoys_MergedOpenConvoyIsLanded(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("mock bd requires sh")
+	}
+	now := time.Now()
+	mergedAt := now.Add(-30 * time.Minute).Format(time.RFC3339)
+	staleAt := now.Add(-3 * 24 * time.Hour).Format(time.RFC3339)
+	openJSON := `[{"id":"hq-cv-merged","title":"merged","status":"open"},` +
+		`{"id":"hq-cv-working","title":"working","status":"open"},` +
+		`{"id":"hq-cv-old","title":"old","status":"open"}]`
+
+	binDir := t.TempDir()
+	script := "#!/bin/sh\ncase \"$*\" in\n" +
+		"  *--status=open*) echo '" + openJSON + "' ;;\n" +
+		"  *'dep list hq-cv-merged'*) echo '[{\"id\":\"gt-a\",\"status\":\"closed\"}]' ;;\n" +
+		"  *'dep list hq-cv-working'*) echo '[{\"id\":\"gt-b\",\"status\":\"open\"}]' ;;\n" +
+		"  *'dep list hq-cv-old'*) echo '[{\"id\":\"gt-c\",\"status\":\"closed\"}]' ;;\n" +
+		"  *'show gt-a'*) echo '[{\"id\":\"gt-a\",\"status\":\"closed\",\"closed_at\":\"" + mergedAt + "\"}]' ;;\n" +
+		"  *'show gt-c'*) echo '[{\"id\":\"gt-c\",\"status\":\"closed\",\"closed_at\":\"" + staleAt + "\"}]' ;;\n" +
+		"  *) echo '[]' ;;\nesac\n"
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir)
+
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	state, err := FetchConvoys(townRoot, 0)
+	if err != nil {
+		t.Fatalf("FetchConvoys: %v", err)
+	}
+
+	if len(state.Landed) != 1 || state.Landed[0].ID != "hq-cv-merged" {
+		t.Fatalf("Landed = %+v, want only hq-cv-merged", state.Landed)
+	}
+	if got := state.Landed[0].LandedAt.Format(time.RFC3339); got != mergedAt {
+		t.Errorf("LandedAt = %s, want merge time %s", got, mergedAt)
+	}
+	var inProgress []string
+	for _, c := range state.InProgress {
+		inProgress = append(inProgress, c.ID)
+	}
+	slices.Sort(inProgress)
+	if !slices.Equal(inProgress, []string{"hq-cv-old", "hq-cv-working"}) {
+		t.Errorf("InProgress = %v, want [hq-cv-old hq-cv-working]", inProgress)
+	}
+}
+
+func TestFetchConvoys_Errors(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("mock bd requires sh")
+	}
+	openJSON := `[{"id":"hq-cv-ok","title":"ok","status":"open"},{"id":"hq-cv-bad","title":"bad","status":"open"}]`
+
+	binDir := t.TempDir()
+	script := "#!/bin/sh\ncase \"$*\" in\n" +
+		"  *--status=open*) [ -n \"$FAIL_LIST\" ] && exit 1; echo '" + openJSON + "' ;;\n" +
+		"  *'dep list hq-cv-ok'*) echo '[{\"id\":\"gt-b\",\"status\":\"open\"}]' ;;\n" +
+		"  *'dep list hq-cv-bad'*) exit 1 ;;\n" +
+		"  *) echo '[]' ;;\nesac\n"
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir)
+
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	state, err := FetchConvoys(townRoot, 0)
+	if err != nil {
+		t.Fatalf("FetchConvoys: %v", err)
+	}
+	if state.ListError != "" {
+		t.Errorf("ListError = %q, want empty when the list succeeds", state.ListError)
+	}
+	tracked := make(map[string]string)
+	for _, c := range state.InProgress {
+		tracked[c.ID] = c.TrackedError
+	}
+	if tracked["hq-cv-ok"] != "" {
+		t.Errorf("hq-cv-ok TrackedError = %q, want empty", tracked["hq-cv-ok"])
+	}
+	if tracked["hq-cv-bad"] == "" {
+		t.Errorf("hq-cv-bad TrackedError empty, want the dep list failure (InProgress = %+v)", state.InProgress)
+	}
+
+	t.Setenv("FAIL_LIST", "1")
+	state, err = FetchConvoys(townRoot, 0)
+	if err != nil {
+		t.Fatalf("FetchConvoys: %v", err)
+	}
+	if state.ListError == "" || state.ToolingError != "" {
+		t.Errorf("ListError = %q, ToolingError = %q, want a list error only", state.ListError, state.ToolingError)
+	}
+}
+
+func TestFetchConvoys_ExcludesArchived(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("mock bd requires sh")
+	}
+	closedAt := time.Now().Add(-2 * 24 * time.Hour).Format(time.RFC3339)
+	closedJSON := `[{"id":"hq-cv-kept","status":"closed","closed_at":"` + closedAt + `"},` +
+		`{"id":"hq-cv-archived","status":"closed","closed_at":"` + closedAt + `","labels":["` + convoy.ArchivedLabel + `"]}]`
+
+	binDir := t.TempDir()
+	script := "#!/bin/sh\ncase \"$*\" in\n  *--status=closed*) echo '" + closedJSON + "' ;;\n  *) echo '[]' ;;\nesac\n"
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir)
+
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	state, err := FetchConvoys(townRoot, 7*24*time.Hour)
+	if err != nil {
+		t.Fatalf("FetchConvoys: %v", err)
+	}
+	if len(state.Landed) != 1 || state.Landed[0].ID != "hq-cv-kept" {
+		t.Errorf("Landed = %+v, want only hq-cv-kept", state.Landed)
+	}
+
+	items, err := listConvoys(context.Background(), filepath.Join(townRoot, ".beads"), "closed")
+	if err != nil {
+		t.Fatalf("listConvoys: %v", err)
+	}
+	if len(items) != 1 || items[0].ID != "hq-cv-kept" {
+		t.Errorf("listConvoys = %+v, want only hq-cv-kept", items)
+	}
+}
+
+func TestFormatLandedWindow(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "24h"},
+		{24 * time.Hour, "24h"},
+		{6 * time.Hour, "6h"},
+		{7 * 24 * time.Hour, "7d"},
+		{36 * time.Hour, "36h"},
+		{90 * time.Minute, "1h30m0s"},
+	}
+	for _, tt := range tests {
+		if got := formatLandedWindow(tt.d); got != tt.want {
+			t.Errorf("formatLandedWindow(%s) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestRenderConvoys_LandedTitleShowsWindow(t *testing.T) {
+	m := NewModel(nil)
+	m.convoyState = &ConvoyState{LandedWindow: 7 * 24 * time.Hour}
+	if out := m.renderConvoys(); !strings.Contains(out, "RECENTLY LANDED (7d)") {
+		t.Errorf("landed title should reflect the window:\n%s", out)
+	}
+}
+
+func TestRenderConvoys_ToolingUnavailableVsEmpty(t *testing.T) {
+	m := NewModel(nil)
+
+	m.convoyState = &ConvoyState{}
+	empty := m.renderConvoys()
+	if !strings.Contains(empty, "No active convoys") {
+		t.Errorf("empty state should say no convoys:\n%s", empty)
+	}
+	if strings.Contains(empty, "unavailable") {
+		t.Errorf("empty state should not mention unavailable tooling:\n%s", empty)
+	}
+
+	m.convoyState = &ConvoyState{ToolingError: "bd not found on PATH"}
+	missing := m.renderConvoys()
+	if !strings.Contains(missing, "Convoy status unavailable: bd not found on PATH") {
+		t.Errorf("missing banner:\n%s", missing)
+	}
+	if strings.Contains(missing, "No active convoys") || strings.Contains(missing, "No recent landings") {
+		t.Errorf("tooling error should not report an empty convoy list:\n%s", missing)
+	}
+	if n := strings.Count(missing, "Convoy status unavailable"); n != 1 {
+		t.Errorf("banner shown %d times, want once", n)
+	}
+}
+
+func TestRenderConvoys_DrainingBanner(t *testing.T) {
+	m := NewModel(nil)
+
+	m.convoyState = &ConvoyState{}
+	if got := m.renderConvoys(); strings.Contains(got, "DRAINING") {
+		t.Errorf("banner shown with no draining rigs:\n%s", got)
+	}
+
+	m.convoyState = &ConvoyState{Draining: []string{"beads", "gastown"}}
+	got := m.renderConvoys()
+	if !strings.Contains(got, "DRAINING: beads, gastown (no new work)") {
+		t.Errorf("missing draining banner:\n%s", got)
+	}
+	if !strings.Contains(got, "No active convoys") {
+		t.Errorf("draining should not hide the convoy sections:\n%s", got)
+	}
+}
+
+func TestConvoySummaryText(t *testing.T) {
+	state := &ConvoyState{
+		InProgress: []Convoy{
+			{ID: "hq-cv-abc", Title: "Auth rewrite", Completed: 2, Total: 4, WorkState: convoy.WorkStateActive},
+			{ID: "hq-cv-def", Title: "A very long convoy title indeed", Completed: 0, Total: 3,
+				WorkState: convoy.WorkStateStuck, StateReason: "no activity for 2h5m"},
+		},
+		Landed: []Convoy{{ID: "hq-cv-old", Title: "Done", Completed: 1, Total: 1}},
+	}
+
+	got := ConvoySummaryText(state)
+
+	if strings.Contains(got, "\x1b") {
+		t.Errorf("summary contains ANSI escapes: %q", got)
+	}
+	for _, want := range []string{
+		"Convoys in progress: 2",
+		"hq-cv-abc  Auth rewrite          2/4 ●●○○  active",
+		"hq-cv-def  A very long convo...  0/3 ○○○  stuck (no activity for 2h5m)",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("summary missing %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "hq-cv-old") {
+		t.Errorf("summary should only list in-progress convoys:\n%s", got)
+	}
+}
+
+func TestConvoySummaryText_Unavailable(t *testing.T) {
+	if got := ConvoySummaryText(nil); got != "Convoy status unavailable\n" {
+		t.Errorf("nil state = %q", got)
+	}
+	got := ConvoySummaryText(&ConvoyState{ToolingError: "bd not found on PATH"})
+	if got != "Convoy status unavailable: bd not found on PATH\n" {
+		t.Errorf("tooling error = %q", got)
+	}
+}
+
+func TestWriteConvoySummary(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not enforced on Windows")
+	}
+	t.Setenv("TMPDIR", t.TempDir())
+
+	first, err := writeConvoySummary("summary\n")
+	if err != nil {
+		t.Fatalf("writeConvoySummary: %v", err)
+	}
+	second, err := writeConvoySummary("summary\n")
+	if err != nil {
+		t.Fatalf("writeConvoySummary: %v", err)
+	}
+	if first == second {
+		t.Errorf("exports share path %s, want a fresh file each time", first)
+	}
+
+	info, err := os.Stat(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0600 {
+		t.Errorf("mode = %o, want 600", mode)
+	}
+	if data, _ := os.ReadFile(first); string(data) != "summary\n" {
+		t.Errorf("content = %q", data)
+	}
+}
+
+func TestRenderConvoyLine_LastActivity(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name         string
+		lastActivity time.Time
+		want         string
+	}{
+		{name: "seconds", lastActivity: now.Add(-42 * time.Second), want: "active 42s ago"},
+		{name: "minutes", lastActivity: now.Add(-4*time.Minute - 10*time.Second), want: "active 4m ago"},
+		{name: "hours", lastActivity: now.Add(-3 * time.Hour), want: "active 3h ago"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line := renderConvoyLine(Convoy{ID: "hq-cv-1", Title: "Ship it", Completed: 1, Total: 2, LastActivity: tt.lastActivity}, false)
+			if !strings.Contains(line, tt.want) {
+				t.Errorf("line = %q, want it to contain %q", line, tt.want)
+			}
+		})
+	}
+
+	line := renderConvoyLine(Convoy{ID: "hq-cv-2", Title: "Unknown", Completed: 0, Total: 1}, false)
+	if strings.Contains(line, "active") {
+		t.Errorf("line = %q, should omit activity when unknown", line)
+	}
+
+	landed := renderConvoyLine(Convoy{ID: "hq-cv-3", Title: "Done", ClosedAt: now.Add(-2 * time.Hour), LastActivity: now}, true)
+	if strings.Contains(landed, "active") {
+		t.Errorf("landed line = %q, should not show activity", landed)
+	}
+}
+
+func TestFormatActivityAge(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{-time.Second, "0s"},
+		{0, "0s"},
+		{59 * time.Second, "59s"},
+		{time.Minute, "1m"},
+		{90 * time.Minute, "1h"},
+		{50 * time.Hour, "2d"},
+	}
+	for _, tt := range tests {
+		if got := formatActivityAge(tt.d); got != tt.want {
+			t.Errorf("formatActivityAge(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestConvoyProgress(t *testing.T) {
+	tests := []struct {
+		name             string
+		completed, total int
+		want             float64
+	}{
+		{name: "no tracked issues", completed: 0, total: 0, want: 0},
+		{name: "partial", completed: 1, total: 4, want: 25},
+		{name: "complete", completed: 3, total: 3, want: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Convoy{ID: "hq-cv-1", Completed: tt.completed, Total: tt.total}
+			if got := c.Progress(); got != tt.want {
+				t.Errorf("Progress() = %v, want %v", got, tt.want)
+			}
+
+			data, err := json.Marshal(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var decoded map[string]any
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatal(err)
+			}
+			if got, ok := decoded["progress_percent"].(float64); !ok || got != tt.want {
+				t.Errorf("progress_percent = %v, want %v in %s", decoded["progress_percent"], tt.want, data)
+			}
+			if decoded["id"] != "hq-cv-1" {
+				t.Errorf("regular fields should still be encoded: %s", data)
+			}
+		})
+	}
+}
@@ -356,8 +356,8 @@ func parseGtEventLine(line string) *Event {
 		return nil
 	}
 
-	t, err := time.Parse(time.RFC3339, ge.Timestamp)
-	if err != nil {
+	t, ok := beads.ParseTime(ge.Timestamp)
+	if !ok {
 		t = time.Now()
 	}
 
@@ -514,6 +514,13 @@ func buildEventMessage(eventType string, payload map[string]interface{}) string
 		}
 		return "mail sent"
 
+	case "convoy_closed":
+		convoy := getPayloadString(payload, "convoy")
+		if convoy != "" {
+			return fmt.Sprintf("convoy closed: %s", convoy)
+		}
+		return "convoy closed"
+
 	case "merged":
 		worker := getPayloadString(payload, "worker")
 		if worker != "" {
@@ -23,6 +23,7 @@ type KeyMap struct {
 	Enter   key.Binding
 	Expand  key.Binding
 	Refresh key.Binding
+	Export  key.Binding
 
 	// Problems view
 	ToggleProblems key.Binding
@@ -98,6 +99,10 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("R"),
 			key.WithHelp("R", "refresh"),
 		),
+		Export: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "export convoys"),
+		),
 		ToggleProblems: key.NewBinding(
 			key.WithKeys("p"),
 			key.WithHelp("p", "toggle problems view"),
@@ -144,7 +149,7 @@ func (k KeyMap) FullHelp() [][]key.Binding {
 		{k.Up, k.Down, k.PageUp, k.PageDown, k.Top, k.Bottom},
 		{k.Tab, k.FocusTree, k.FocusConvoy, k.FocusFeed, k.Enter, k.Expand},
 		{k.ToggleProblems, k.Nudge, k.Handoff},
-		{k.Search, k.Filter, k.ClearFilter, k.Refresh},
+		{k.Search, k.Filter, k.ClearFilter, k.Refresh, k.Export},
 		{k.Help, k.Quit},
 	}
 }
@@ -1,6 +1,9 @@
 package feed
 
 import (
+	"context"
+	"fmt"
+	"os"
 	"os/exec"
 	"sync"
 	"time"
@@ -88,12 +91,20 @@ type Model struct {
 	convoyState *ConvoyState
 	townRoot    string
 
+	// landedWindow is the lookback for the recently-landed convoy section
+	// (zero means DefaultLandedWindow).
+	landedWindow time.Duration
+
 	// UI state
 	keys     KeyMap
 	help     help.Model
 	showHelp bool
 	filter   string
 
+	// statusNote is a one-off message shown in the status bar (e.g. the
+	// result of a convoy export). Cleared on the next key press.
+	statusNote string
+
 	// View mode
 	viewMode ViewMode
 
@@ -111,9 +122,17 @@ type Model struct {
 	done      chan struct{}
 	closeOnce sync.Once
 
+	// ctx is cancelled on shutdown to kill in-flight convoy fetches;
+	// fetches tracks them so Stop can wait for them to return. fetchMu
+	// orders fetches.Add against cancellation.
+	ctx     context.Context
+	cancel  context.CancelFunc
+	fetches sync.WaitGroup
+	fetchMu sync.Mutex
+
 	// mu protects all fields read by View() from concurrent access:
-	// events, rigs, convoyState, eventChan, townRoot, width, height,
-	// focusedPanel, showHelp, help, filter, viewMode, problemAgents,
+	// events, rigs, convoyState, eventChan, townRoot, landedWindow, width, height,
+	// focusedPanel, showHelp, help, filter, statusNote, viewMode, problemAgents,
 	// selectedProblem, selectedBeadID, problemsError, lastProblemsCheck,
 	// and all viewports. Write lock is held during Update/handleKey
 	// mutations; read lock is held during View/render.
@@ -125,6 +144,7 @@ type Model struct {
 func NewModel(bd *beads.Beads) *Model {
 	h := help.New()
 	h.ShowAll = false
+	ctx, cancel := context.WithCancel(context.Background())
 
 	return &Model{
 		focusedPanel:     PanelTree,
@@ -138,6 +158,8 @@ func NewModel(bd *beads.Beads) *Model {
 		keys:             DefaultKeyMap(),
 		help:             h,
 		done:             make(chan struct{}),
+		ctx:              ctx,
+		cancel:           cancel,
 		viewMode:         ViewActivity,
 		stuckDetector:    NewStuckDetector(bd),
 	}
@@ -160,6 +182,47 @@ func (m *Model) SetTownRoot(townRoot string) {
 	m.mu.Unlock()
 }
 
+// SetLandedWindow sets how far back the convoy panel looks for landed
+// convoys. Safe to call concurrently with the Bubble Tea event loop.
+func (m *Model) SetLandedWindow(d time.Duration) {
+	m.mu.Lock()
+	m.landedWindow = d
+	m.mu.Unlock()
+}
+
+// fetchConvoyState fetches the convoy panel's data.
+// Tests override this variable to avoid shelling out to bd.
+var fetchConvoyState = FetchConvoysContext
+
+// shutdown stops event listening and cancels in-flight convoy fetches
+// without waiting for them. Safe to call more than once.
+func (m *Model) shutdown() {
+	m.closeOnce.Do(func() { close(m.done) })
+	m.fetchMu.Lock()
+	m.cancel()
+	m.fetchMu.Unlock()
+}
+
+// beginFetch registers an in-flight convoy fetch. It returns false once the
+// model has been shut down; otherwise the caller must call m.fetches.Done.
+func (m *Model) beginFetch() bool {
+	m.fetchMu.Lock()
+	defer m.fetchMu.Unlock()
+	if m.ctx.Err() != nil {
+		return false
+	}
+	m.fetches.Add(1)
+	return true
+}
+
+// Stop shuts the model down and waits for in-flight convoy fetches to
+// return. No further fetches start afterwards. Call it after the Bubble
+// Tea program exits so no goroutines or bd subprocesses outlive the TUI.
+func (m *Model) Stop() {
+	m.shutdown()
+	m.fetches.Wait()
+}
+
 // Init initializes the model
 func (m *Model) Init() tea.Cmd {
 	cmds := []tea.Cmd{
@@ -226,18 +289,27 @@ func tick() tea.Cmd {
 	})
 }
 
-// fetchConvoys returns a command that fetches convoy data.
-// Captures townRoot under the read lock to avoid racing with SetTownRoot.
+// fetchConvoys returns a command that fetches convoy data, or nil once the
+// model has been stopped. Captures townRoot and landedWindow under the read
+// lock to avoid racing with their setters.
 func (m *Model) fetchConvoys() tea.Cmd {
 	m.mu.RLock()
 	townRoot := m.townRoot
+	landedWindow := m.landedWindow
 	m.mu.RUnlock()
 
-	if townRoot == "" {
+	if townRoot == "" || m.ctx.Err() != nil {
 		return nil
 	}
 	return func() tea.Msg {
-		state, _ := FetchConvoys(townRoot)
+		if !m.beginFetch() {
+			return nil
+		}
+		defer m.fetches.Done()
+		state, err := fetchConvoyState(m.ctx, townRoot, landedWindow)
+		if err != nil && m.ctx.Err() != nil {
+			return nil
+		}
 		return convoyUpdateMsg{state: state}
 	}
 }
@@ -361,9 +433,13 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // handleKey processes key presses
 func (m *Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.mu.Lock()
+	m.statusNote = ""
+	m.mu.Unlock()
+
 	switch {
 	case key.Matches(msg, m.keys.Quit):
-		m.closeOnce.Do(func() { close(m.done) })
+		m.shutdown()
 		return m, tea.Quit
 
 	case key.Matches(msg, m.keys.Help):
@@ -410,6 +486,11 @@ func (m *Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case key.Matches(msg, m.keys.Export):
+		if m.viewMode == ViewActivity && m.focusedPanel == PanelConvoy {
+			return m.exportConvoySummary()
+		}
+
 	case key.Matches(msg, m.keys.Enter):
 		if m.viewMode == ViewProblems {
 			return m.attachToSelected()
@@ -608,7 +689,7 @@ func (m *Model) attachToSelected() (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 	// Exit TUI and switch to/attach tmux session
-	m.closeOnce.Do(func() { close(m.done) })
+	m.shutdown()
 	var c *exec.Cmd
 	if tmux.IsInSameSocket() {
 		// Same tmux socket: switch the current client to the target session
@@ -673,6 +754,47 @@ func (m *Model) handoffSelected() (tea.Model, tea.Cmd) {
 	})
 }
 
+// exportConvoySummary writes a plain-text summary of the in-progress convoys
+// to a new private file in the temp dir for sharing, and reports the path in
+// the status bar.
+func (m *Model) exportConvoySummary() (tea.Model, tea.Cmd) {
+	m.mu.RLock()
+	text := ConvoySummaryText(m.convoyState)
+	m.mu.RUnlock()
+
+	var note string
+	if path, err := writeConvoySummary(text); err != nil {
+		note = fmt.Sprintf("convoy export failed: %v", err)
+	} else {
+		note = "convoy summary written to " + path
+	}
+
+	m.mu.Lock()
+	m.statusNote = note
+	m.mu.Unlock()
+	return m, nil
+}
+
+// writeConvoySummary writes text to a uniquely named temp file. os.CreateTemp
+// opens it with mode 0600 and refuses to reuse an existing path, so another
+// user cannot pre-create or read the export.
+func writeConvoySummary(text string) (string, error) {
+	f, err := os.CreateTemp("", "gt-convoys-*.txt")
+	if err != nil {
+		return "", err
+	}
+	if _, err := f.WriteString(text); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
 // updateViewportSizes recalculates viewport dimensions.
 // Acquires the write lock for the entire operation so that reads of
 // width/height/showHelp and writes to viewports are atomic with View().
@@ -0,0 +1,110 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// blockingFetch replaces fetchConvoyState with a fetch that counts calls
+// and blocks until its context is cancelled, like a hung bd subprocess.
+func blockingFetch(t *testing.T) (calls *atomic.Int32, started chan struct{}) {
+	t.Helper()
+	calls = &atomic.Int32{}
+	started = make(chan struct{}, 10)
+	orig := fetchConvoyState
+	fetchConvoyState = func(ctx context.Context, townRoot string, landedWindow time.Duration) (*ConvoyState, error) {
+		calls.Add(1)
+		started <- struct{}{}
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	t.Cleanup(func() { fetchConvoyState = orig })
+	return calls, started
+}
+
+func TestModelStop_CancelsInFlightFetch(t *testing.T) {
+	calls, started := blockingFetch(t)
+
+	m := NewModel(nil)
+	m.SetTownRoot(t.TempDir())
+
+	cmd := m.fetchConvoys()
+	if cmd == nil {
+		t.Fatal("fetchConvoys returned nil before Stop")
+	}
+	msgs := make(chan interface{}, 1)
+	go func() { msgs <- cmd() }()
+
+	select {
+	case <-started:
+	case <-time.After(2 * time.Second):
+		t.Fatal("fetch never started")
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		m.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Stop did not return promptly")
+	}
+
+	// The cancelled fetch returned before Stop did and produced no update.
+	select {
+	case msg := <-msgs:
+		if msg != nil {
+			t.Errorf("cancelled fetch produced %#v, want nil", msg)
+		}
+	default:
+		t.Error("Stop returned before the in-flight fetch finished")
+	}
+
+	// No further fetches after Stop: neither a new command nor a refresh tick.
+	if cmd := m.fetchConvoys(); cmd != nil {
+		t.Error("fetchConvoys returned a command after Stop")
+	}
+	if _, next := m.Update(convoyUpdateMsg{}); next != nil {
+		next()
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("fetch called %d times, want 1", got)
+	}
+}
+
+func TestModelStop_PendingCommandDoesNotFetch(t *testing.T) {
+	calls, _ := blockingFetch(t)
+
+	m := NewModel(nil)
+	m.SetTownRoot(t.TempDir())
+
+	// A command created before Stop but run after it must not fetch.
+	cmd := m.fetchConvoys()
+	m.Stop()
+	if msg := cmd(); msg != nil {
+		t.Errorf("pending fetch produced %#v after Stop, want nil", msg)
+	}
+	if got := calls.Load(); got != 0 {
+		t.Errorf("fetch called %d times after Stop, want 0", got)
+	}
+
+	m.Stop() // idempotent
+}
+
+func TestFetchConvoysContext_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	state, err := FetchConvoysContext(ctx, t.TempDir(), 0)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+	if state != nil {
+		t.Errorf("state = %+v, want nil", state)
+	}
+}
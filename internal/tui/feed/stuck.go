@@ -211,12 +211,7 @@ func (d *StuckDetector) analyzeAgent(id string, issue *beads.Issue) *ProblemAgen
 	}
 
 	// Parse staleness from UpdatedAt
-	updatedAt, err := time.Parse(time.RFC3339, issue.UpdatedAt)
-	if err != nil {
-		// Try alternate format (some beads use different timestamp formats)
-		updatedAt, err = time.Parse("2006-01-02T15:04:05", issue.UpdatedAt)
-	}
-	if err == nil {
+	if updatedAt, ok := beads.ParseTime(issue.UpdatedAt); ok {
 		agent.LastActivity = updatedAt
 		agent.IdleMinutes = int(time.Since(updatedAt).Minutes())
 	}
@@ -504,6 +504,9 @@ func (m *Model) renderStatusBar() string {
 			panelName = "feed"
 		}
 		left = fmt.Sprintf("[%s] %d events", panelName, len(m.events))
+		if m.statusNote != "" {
+			left += " | " + m.statusNote
+		}
 	}
 
 	// Short help